@@ -0,0 +1,132 @@
+package main
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/rivo/uniseg"
+)
+
+// TextStats is the result of Analyze. Characters are extended grapheme clusters.
+type TextStats struct {
+	Bytes      int64            `json:"bytes"`
+	Runes      int64            `json:"runes"`
+	Graphemes  int64            `json:"graphemes"`
+	Words      int64            `json:"words"`
+	Sentences  int64            `json:"sentences"`
+	Lines      int64            `json:"lines"`
+	Whitespace int64            `json:"whitespace"`
+	Distinct   int64            `json:"distinct"`
+	Scripts    []string         `json:"scripts"`
+	Frequency  map[string]int64 `json:"frequency"`
+}
+
+// scriptNames lists the specific scripts; Common and Inherited are never reported.
+var scriptNames = func() []string {
+	names := make([]string, 0, len(unicode.Scripts))
+	for name := range unicode.Scripts {
+		if name != "Common" && name != "Inherited" {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}()
+
+// scriptOf returns the Unicode script of r, or "" for Common and Inherited runes.
+func scriptOf(r rune) string {
+	if r < utf8.RuneSelf {
+		if unicode.IsLetter(r) {
+			return "Latin"
+		}
+		return ""
+	}
+	for _, name := range scriptNames {
+		if unicode.Is(unicode.Scripts[name], r) {
+			return name
+		}
+	}
+	return ""
+}
+
+// isWordSegment reports whether a UAX #29 word segment is a word rather than
+// whitespace or punctuation.
+func isWordSegment(seg string) bool {
+	for _, r := range seg {
+		if unicode.IsLetter(r) || unicode.IsNumber(r) {
+			return true
+		}
+	}
+	return false
+}
+
+func analyzeString(s string) TextStats {
+	stats := TextStats{
+		Bytes:     int64(len(s)),
+		Runes:     int64(utf8.RuneCountInString(s)),
+		Scripts:   []string{},
+		Frequency: map[string]int64{},
+	}
+
+	scripts := map[string]int{}
+	seen := map[rune]string{}
+	for _, r := range s {
+		if unicode.IsSpace(r) {
+			stats.Whitespace++
+		}
+		script, ok := seen[r]
+		if !ok {
+			script = scriptOf(r)
+			seen[r] = script
+		}
+		if script != "" {
+			scripts[script]++
+		}
+	}
+	for script := range scripts {
+		stats.Scripts = append(stats.Scripts, script)
+	}
+	sort.Slice(stats.Scripts, func(i, j int) bool {
+		a, b := stats.Scripts[i], stats.Scripts[j]
+		if scripts[a] != scripts[b] {
+			return scripts[a] > scripts[b]
+		}
+		return a < b
+	})
+
+	gr := uniseg.NewGraphemes(s)
+	for gr.Next() {
+		stats.Graphemes++
+		stats.Frequency[gr.Str()]++
+	}
+	stats.Distinct = int64(len(stats.Frequency))
+
+	state := -1
+	for rest := s; rest != ""; {
+		var word string
+		word, rest, state = uniseg.FirstWordInString(rest, state)
+		if isWordSegment(word) {
+			stats.Words++
+		}
+	}
+
+	state = -1
+	for rest := s; rest != ""; {
+		var sentence string
+		sentence, rest, state = uniseg.FirstSentenceInString(rest, state)
+		if strings.TrimSpace(sentence) != "" {
+			stats.Sentences++
+		}
+	}
+
+	if s != "" {
+		stats.Lines = int64(strings.Count(s, "\n"))
+		if !strings.HasSuffix(s, "\n") {
+			stats.Lines++
+		}
+	}
+
+	return stats
+}
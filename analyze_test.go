@@ -0,0 +1,38 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnalyzeMixedScripts(t *testing.T) {
+	s := "Hello мир! Привет, 世界。\nΓειά σου 👋🏽 é"
+	stats := analyzeString(s)
+
+	assert.Equal(t, int64(66), stats.Bytes)
+	assert.Equal(t, int64(37), stats.Runes)
+	// The skin tone modifier and the combining accent join the previous rune.
+	assert.Equal(t, int64(35), stats.Graphemes)
+	// Han ideographs are words of their own.
+	assert.Equal(t, int64(8), stats.Words)
+	assert.Equal(t, int64(3), stats.Sentences)
+	assert.Equal(t, int64(2), stats.Lines)
+	assert.Equal(t, int64(7), stats.Whitespace)
+	assert.Equal(t, int64(27), stats.Distinct)
+	// Scripts are ordered by rune count; punctuation, spaces and emoji are Common.
+	assert.Equal(t, []string{"Cyrillic", "Greek", "Latin", "Han"}, stats.Scripts)
+	assert.Equal(t, int64(2), stats.Frequency["р"])
+	assert.Equal(t, int64(1), stats.Frequency["👋🏽"])
+	assert.Equal(t, int64(1), stats.Frequency["é"])
+	assert.Equal(t, int64(6), stats.Frequency[" "])
+}
+
+func TestAnalyzeEmpty(t *testing.T) {
+	stats := analyzeString("")
+	assert.Equal(t, TextStats{Scripts: []string{}, Frequency: map[string]int64{}}, stats)
+
+	stats = analyzeString("one\ntwo\n")
+	assert.Equal(t, int64(2), stats.Lines)
+	assert.Equal(t, int64(2), stats.Words)
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-kit/kit/endpoint"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// unauthenticatedError wraps JWT parser errors for transports that
+// authenticate outside a go-kit server.
+type unauthenticatedError struct {
+	err error
+}
+
+func (e unauthenticatedError) Error() string {
+	return e.err.Error()
+}
+
+// StatusCode implements httptransport.StatusCoder.
+func (e unauthenticatedError) StatusCode() int {
+	return http.StatusUnauthorized
+}
+
+func (e unauthenticatedError) GRPCStatus() *status.Status {
+	return status.New(codes.Unauthenticated, e.err.Error())
+}
+
+// authenticateContext runs the JWT parser once and returns the context
+// carrying the claims, for transports serving many messages per request.
+func authenticateContext(ctx context.Context, parser endpoint.Middleware) (context.Context, error) {
+	claimed, err := parser(func(ctx context.Context, _ interface{}) (interface{}, error) {
+		return ctx, nil
+	})(ctx, nil)
+	if err != nil {
+		return nil, unauthenticatedError{err}
+	}
+
+	return claimed.(context.Context), nil
+}
+
+// authenticateMiddleware runs the JWT parser like gokitjwt.NewParser, but
+// reports its failures as unauthenticatedError for transports without a
+// status of their own.
+func authenticateMiddleware(parser endpoint.Middleware) endpoint.Middleware {
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, request interface{}) (interface{}, error) {
+			ctx, err := authenticateContext(ctx, parser)
+			if err != nil {
+				return nil, err
+			}
+			return next(ctx, request)
+		}
+	}
+}
@@ -2,13 +2,32 @@ package main
 
 import (
 	"errors"
+	"net/http"
 	"time"
 
 	"github.com/dgrijalva/jwt-go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const expiration = 120
 
+// credentialsError is returned for an unknown username or a wrong password.
+type credentialsError struct{}
+
+func (credentialsError) Error() string {
+	return "incorrect credentials"
+}
+
+// StatusCode implements httptransport.StatusCoder.
+func (credentialsError) StatusCode() int {
+	return http.StatusUnauthorized
+}
+
+func (e credentialsError) GRPCStatus() *status.Status {
+	return status.New(codes.Unauthenticated, e.Error())
+}
+
 type AuthService interface {
 	Auth(string, string) (string, error)
 }
@@ -36,13 +55,12 @@ func generateToken(signingKey []byte, username string) (string, error) {
 }
 
 func (as authService) Auth(username string, password string) (string, error) {
-	if as.clients[username] == password {
+	if known, ok := as.clients[username]; ok && known == password {
 		signed, err := generateToken(as.key, username)
 		if err != nil {
 			return "", errors.New(err.Error())
 		}
 		return signed, nil
 	}
-	err := errors.New("incorrect credentials")
-	return "", err
+	return "", credentialsError{}
 }
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/fnaumov/gokit-stringsvc/pb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthWrongPassword(t *testing.T) {
+	_, err := authConfig.Auth("user1", "guess")
+	assert.Equal(t, credentialsError{}, err)
+	_, err = authConfig.Auth("nobody", "")
+	assert.Equal(t, credentialsError{}, err)
+
+	srv := httptest.NewServer(makeHTTPHandler(makeSvc(), makeJobs(makeSvc())))
+	defer srv.Close()
+	resp, err := http.Post(srv.URL+"/auth", "application/json", strings.NewReader(`{"username": "user1", "password": "guess"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	conn, stop := dialTestGRPCServer(t, makeSvc())
+	defer stop()
+	_, err = pb.NewStringServiceClient(conn).Auth(context.Background(), &pb.AuthRequest{Username: "user1", Password: "guess"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	assert.Equal(t, "incorrect credentials", status.Convert(err).Message())
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// Operations accepted by batch items.
+const (
+	OpUppercase = "uppercase"
+	OpLowercase = "lowercase"
+	OpTitleCase = "titlecase"
+	OpCount     = "count"
+)
+
+var ErrUnknownOp = errors.New("unknown operation")
+
+type batchItem struct {
+	Op string `json:"op"`
+	S  string `json:"s"`
+}
+
+// batchResult holds V for case operations and N for count. Both are always
+// encoded, so that an empty string or a zero count is not mistaken for a
+// missing result.
+type batchResult struct {
+	V   string `json:"v"`
+	N   int64  `json:"n"`
+	Err string `json:"err,omitempty"`
+}
+
+// applyBatchItem runs a single item through the StringService. A panic only
+// fails its own item, since it happens outside the endpoint's goroutine.
+func applyBatchItem(ctx context.Context, svc StringService, item batchItem) (result batchResult) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = batchResult{Err: logPanic(ctx, logger, panicsTotal, "batch", r).Error()}
+		}
+	}()
+
+	if err := ctx.Err(); err != nil {
+		return batchResult{Err: err.Error()}
+	}
+
+	var err error
+	switch item.Op {
+	case OpUppercase:
+		result.V, err = svc.Uppercase(item.S, "")
+	case OpLowercase:
+		result.V, err = svc.Lowercase(item.S, "")
+	case OpTitleCase:
+		result.V, err = svc.TitleCase(item.S, "")
+	case OpCount:
+		result.N, err = svc.Count(item.S, "")
+	default:
+		err = ErrUnknownOp
+	}
+	if err != nil {
+		return batchResult{Err: err.Error()}
+	}
+	return result
+}
+
+// runBatch processes items on at most workers goroutines and returns the
+// results in the order of the items.
+func runBatch(ctx context.Context, svc StringService, items []batchItem, workers int) []batchResult {
+	results := make([]batchResult, len(items))
+	if workers > len(items) {
+		workers = len(items)
+	}
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				results[i] = applyBatchItem(ctx, svc, items[i])
+			}
+		}()
+	}
+
+	for i := range items {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	return results
+}
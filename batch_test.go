@@ -0,0 +1,87 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// concurrencyService records how many Uppercase calls run at once.
+type concurrencyService struct {
+	StringService
+	running int32
+	peak    int32
+}
+
+func (s *concurrencyService) Uppercase(v string, locale string) (string, error) {
+	n := atomic.AddInt32(&s.running, 1)
+	defer atomic.AddInt32(&s.running, -1)
+	for {
+		peak := atomic.LoadInt32(&s.peak)
+		if n <= peak || atomic.CompareAndSwapInt32(&s.peak, peak, n) {
+			break
+		}
+	}
+	time.Sleep(time.Millisecond)
+	return s.StringService.Uppercase(v, locale)
+}
+
+func TestRunBatch(t *testing.T) {
+	svc := &concurrencyService{StringService: stringService{}}
+	items := make([]batchItem, 50)
+	for i := range items {
+		items[i] = batchItem{OpUppercase, string(rune('a' + i%26))}
+	}
+
+	results := runBatch(context.Background(), svc, items, 4)
+	for i, result := range results {
+		assert.Equal(t, string(rune('A'+i%26)), result.V, "results keep the order of the items")
+	}
+	assert.True(t, atomic.LoadInt32(&svc.peak) <= 4, "at most 4 items run at once")
+	assert.True(t, atomic.LoadInt32(&svc.peak) > 1, "items run concurrently")
+}
+
+func TestRunBatchItemErrors(t *testing.T) {
+	items := []batchItem{
+		{OpCount, "héllo"},
+		{"reverse", "abc"},
+		{OpLowercase, ""},
+		{OpTitleCase, "hello world"},
+		{OpCount, ""},
+	}
+
+	results := runBatch(context.Background(), stringService{}, items, 2)
+	assert.Equal(t, []batchResult{
+		{N: 6},
+		{Err: ErrUnknownOp.Error()},
+		{Err: ErrEmpty.Error()},
+		{V: "Hello World"},
+		{N: 0},
+	}, results)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	results = runBatch(ctx, stringService{}, items[:1], 2)
+	assert.Equal(t, context.Canceled.Error(), results[0].Err)
+}
+
+func TestBatchResultEncodesZeroValues(t *testing.T) {
+	b, err := json.Marshal(batchResponse{[]batchResult{{N: 0}, {V: ""}, {Err: "failed"}}})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"results": [{"v": "", "n": 0}, {"v": "", "n": 0}, {"v": "", "n": 0, "err": "failed"}]}`, string(b))
+}
+
+func TestBatchSizeLimit(t *testing.T) {
+	items := make([]batchItem, limits.maxBatchItems)
+	for i := range items {
+		items[i] = batchItem{OpCount, "a"}
+	}
+	assert.NoError(t, batchRequest{Items: items}.validate())
+
+	err := batchRequest{Items: append(items, batchItem{OpCount, "a"})}.validate()
+	assert.Equal(t, validationError{{"items", "exceeds 1000 items"}}, err)
+}
@@ -0,0 +1,227 @@
+package main
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// CacheBackend stores cached results by key. The in-process LRU is the
+// default; a shared store such as Redis can implement it too, reporting
+// unavailable entries as misses.
+type CacheBackend interface {
+	Get(key string) ([]byte, bool)
+	Set(key string, value []byte, ttl time.Duration)
+}
+
+// lruCache is a CacheBackend bounded by the total size of its keys and values.
+type lruCache struct {
+	mtx      sync.Mutex
+	maxBytes int
+	bytes    int
+	order    *list.List
+	entries  map[string]*list.Element
+	now      func() time.Time
+}
+
+type lruEntry struct {
+	key     string
+	value   []byte
+	expires time.Time
+}
+
+func newLRUCache(maxBytes int) *lruCache {
+	return &lruCache{
+		maxBytes: maxBytes,
+		order:    list.New(),
+		entries:  map[string]*list.Element{},
+		now:      time.Now,
+	}
+}
+
+func (c *lruCache) Get(key string) ([]byte, bool) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	el, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	entry := el.Value.(*lruEntry)
+	if c.now().After(entry.expires) {
+		c.remove(el)
+		return nil, false
+	}
+	c.order.MoveToFront(el)
+	return entry.value, true
+}
+
+func (c *lruCache) Set(key string, value []byte, ttl time.Duration) {
+	size := len(key) + len(value)
+	if size > c.maxBytes {
+		return
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if el, ok := c.entries[key]; ok {
+		c.remove(el)
+	}
+	c.entries[key] = c.order.PushFront(&lruEntry{key, value, c.now().Add(ttl)})
+	c.bytes += size
+	for c.bytes > c.maxBytes {
+		c.remove(c.order.Back())
+	}
+}
+
+func (c *lruCache) remove(el *list.Element) {
+	entry := c.order.Remove(el).(*lruEntry)
+	delete(c.entries, entry.key)
+	c.bytes -= len(entry.key) + len(entry.value)
+}
+
+// cacheKey identifies an operation on its parameters and input by a hash,
+// so keys stay short whatever the size of the input.
+func cacheKey(op string, parts ...string) string {
+	h := sha256.New()
+	var size [8]byte
+	for _, p := range parts {
+		binary.BigEndian.PutUint64(size[:], uint64(len(p)))
+		h.Write(size[:])
+		h.Write([]byte(p))
+	}
+	return op + ":" + hex.EncodeToString(h.Sum(nil))
+}
+
+// cachingMiddleware caches the results of deterministic operations; other
+// methods go straight to the embedded service. Concurrent identical requests
+// share one call to the next service. Errors and results larger than
+// maxEntry bytes are never cached. It sits inside loggingMiddleware, so that
+// cache hits are logged like any other call.
+type cachingMiddleware struct {
+	StringService
+	backend  CacheBackend
+	ttl      time.Duration
+	group    *singleflight.Group
+	maxEntry int
+}
+
+// uncached returns svc without its caching middleware, for the streaming
+// handlers whose chunks would only evict useful entries.
+func uncached(svc StringService) StringService {
+	switch mw := svc.(type) {
+	case cachingMiddleware:
+		return uncached(mw.StringService)
+	case loggingMiddleware:
+		return loggingMiddleware{mw.auth, mw.logger, uncached(mw.next)}
+	}
+	return svc
+}
+
+func (mw cachingMiddleware) cached(key string, fn func() ([]byte, error)) ([]byte, error) {
+	if v, ok := mw.backend.Get(key); ok {
+		cacheHits.Add(1)
+		return v, nil
+	}
+	cacheMisses.Add(1)
+
+	v, err, _ := mw.group.Do(key, func() (interface{}, error) {
+		b, err := fn()
+		if err == nil && len(b) <= mw.maxEntry {
+			mw.backend.Set(key, b, mw.ttl)
+		}
+		return b, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.([]byte), nil
+}
+
+func (mw cachingMiddleware) cachedString(key string, fn func() (string, error)) (string, error) {
+	v, err := mw.cached(key, func() ([]byte, error) {
+		s, err := fn()
+		return []byte(s), err
+	})
+	return string(v), err
+}
+
+func (mw cachingMiddleware) Uppercase(s string, locale string) (string, error) {
+	return mw.cachedString(cacheKey("uppercase", locale, s), func() (string, error) {
+		return mw.StringService.Uppercase(s, locale)
+	})
+}
+
+func (mw cachingMiddleware) Lowercase(s string, locale string) (string, error) {
+	return mw.cachedString(cacheKey("lowercase", locale, s), func() (string, error) {
+		return mw.StringService.Lowercase(s, locale)
+	})
+}
+
+func (mw cachingMiddleware) TitleCase(s string, locale string) (string, error) {
+	return mw.cachedString(cacheKey("titlecase", locale, s), func() (string, error) {
+		return mw.StringService.TitleCase(s, locale)
+	})
+}
+
+func (mw cachingMiddleware) Count(s string, unit string) (int64, error) {
+	v, err := mw.cachedString(cacheKey("count", unit, s), func() (string, error) {
+		n, err := mw.StringService.Count(s, unit)
+		return strconv.FormatInt(n, 10), err
+	})
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(v, 10, 64)
+}
+
+func (mw cachingMiddleware) Normalize(s string, opts NormalizeOptions) (string, error) {
+	key := cacheKey("normalize", opts.Form, strconv.FormatBool(opts.CaseFold), strconv.FormatBool(opts.StripDiacritics), s)
+	return mw.cachedString(key, func() (string, error) {
+		return mw.StringService.Normalize(s, opts)
+	})
+}
+
+func (mw cachingMiddleware) Encode(s string, encoding string) (string, error) {
+	return mw.cachedString(cacheKey("encode", encoding, s), func() (string, error) {
+		return mw.StringService.Encode(s, encoding)
+	})
+}
+
+func (mw cachingMiddleware) Decode(s string, encoding string) (string, error) {
+	return mw.cachedString(cacheKey("decode", encoding, s), func() (string, error) {
+		return mw.StringService.Decode(s, encoding)
+	})
+}
+
+func (mw cachingMiddleware) Hash(s string, opts HashOptions) (string, error) {
+	return mw.cachedString(cacheKey("hash", opts.Algorithm, opts.Output, opts.Key, s), func() (string, error) {
+		return mw.StringService.Hash(s, opts)
+	})
+}
+
+func (mw cachingMiddleware) Slugify(s string, opts SlugOptions) (string, error) {
+	key := cacheKey("slugify", append([]string{opts.Separator, strconv.Itoa(opts.MaxLength), s}, opts.Existing...)...)
+	return mw.cachedString(key, func() (string, error) {
+		return mw.StringService.Slugify(s, opts)
+	})
+}
+
+func (mw cachingMiddleware) Truncate(s string, opts TruncateOptions) (string, error) {
+	return mw.cachedString(cacheKey("truncate", opts.Unit, strconv.Itoa(opts.Max), opts.Ellipsis, s), func() (string, error) {
+		return mw.StringService.Truncate(s, opts)
+	})
+}
+
+func (mw cachingMiddleware) Wrap(s string, width int) (string, error) {
+	return mw.cachedString(cacheKey("wrap", strconv.Itoa(width), s), func() (string, error) {
+		return mw.StringService.Wrap(s, width)
+	})
+}
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"bytes"
+	"expvar"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/sync/singleflight"
+)
+
+// countingService counts Uppercase calls, each waiting for release.
+type countingService struct {
+	StringService
+	calls   int32
+	release chan struct{}
+}
+
+func (s *countingService) Uppercase(v string, locale string) (string, error) {
+	atomic.AddInt32(&s.calls, 1)
+	<-s.release
+	return stringService{}.Uppercase(v, locale)
+}
+
+func cacheCount(name string) float64 {
+	return expvar.Get(name).(*expvar.Float).Value()
+}
+
+func TestLRUCache(t *testing.T) {
+	now := time.Now()
+	c := newLRUCache(15)
+	c.now = func() time.Time { return now }
+
+	c.Set("a", []byte("12345"), time.Minute)
+	c.Set("b", []byte("12345"), time.Minute)
+	c.Set("c", []byte("12345"), time.Second)
+	_, ok := c.Get("a")
+	assert.False(t, ok, "oldest entry is evicted past the size limit")
+
+	v, ok := c.Get("b")
+	assert.True(t, ok)
+	assert.Equal(t, "12345", string(v))
+
+	now = now.Add(2 * time.Second)
+	_, ok = c.Get("c")
+	assert.False(t, ok, "expired entry is dropped")
+	_, ok = c.Get("b")
+	assert.True(t, ok)
+
+	c.Set("big", make([]byte, 30), time.Minute)
+	_, ok = c.Get("big")
+	assert.False(t, ok, "entries larger than the cache are not stored")
+}
+
+func TestCachingMiddleware(t *testing.T) {
+	next := &countingService{release: make(chan struct{})}
+	svc := cachingMiddleware{next, newLRUCache(1 << 10), time.Minute, &singleflight.Group{}, 1 << 10}
+	hits, misses := cacheCount("cache_hits"), cacheCount("cache_misses")
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			v, err := svc.Uppercase("hello", "")
+			assert.NoError(t, err)
+			assert.Equal(t, "HELLO", v)
+		}()
+	}
+	// Let the concurrent misses queue up behind the first call.
+	time.Sleep(50 * time.Millisecond)
+	close(next.release)
+	wg.Wait()
+
+	v, err := svc.Uppercase("hello", "")
+	assert.NoError(t, err)
+	assert.Equal(t, "HELLO", v)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&next.calls), "concurrent and later requests share one call")
+	assert.True(t, cacheCount("cache_hits") >= hits+1)
+	assert.Equal(t, hits+misses+6, cacheCount("cache_hits")+cacheCount("cache_misses"))
+
+	_, err = svc.Uppercase("hello", "tr")
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&next.calls), "parameters are part of the key")
+
+	_, err = svc.Uppercase("", "")
+	assert.Equal(t, ErrEmpty, err, "errors are returned unchanged")
+}
+
+func TestCachingMiddlewareEntryLimit(t *testing.T) {
+	next := &countingService{release: make(chan struct{})}
+	close(next.release)
+	svc := cachingMiddleware{next, newLRUCache(1 << 20), time.Minute, &singleflight.Group{}, 8}
+
+	for i := 0; i < 2; i++ {
+		_, _ = svc.Uppercase("short", "")
+		_, _ = svc.Uppercase("longer than eight bytes", "")
+	}
+	assert.Equal(t, int32(3), atomic.LoadInt32(&next.calls), "only the short result is cached")
+}
+
+func TestCacheHitsAreLogged(t *testing.T) {
+	var buf bytes.Buffer
+	next := &countingService{release: make(chan struct{})}
+	close(next.release)
+	cached := cachingMiddleware{next, newLRUCache(1 << 10), time.Minute, &singleflight.Group{}, 1 << 10}
+	svc := loggingMiddleware{authConfig, log.NewLogfmtLogger(&buf), cached}
+
+	_, _ = svc.Uppercase("hello", "")
+	_, _ = svc.Uppercase("hello", "")
+	assert.Equal(t, int32(1), atomic.LoadInt32(&next.calls))
+	assert.Equal(t, 2, strings.Count(buf.String(), "method=uppercase"))
+
+	// Streaming handlers keep the logging but bypass the cache.
+	stream := uncached(svc)
+	_, _ = stream.Uppercase("hello", "")
+	assert.Equal(t, int32(2), atomic.LoadInt32(&next.calls))
+	assert.Equal(t, 3, strings.Count(buf.String(), "method=uppercase"))
+}
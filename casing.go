@@ -0,0 +1,59 @@
+package main
+
+import (
+	"errors"
+	"unicode/utf8"
+
+	"github.com/rivo/uniseg"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+)
+
+// Units accepted by Count. An empty unit counts bytes.
+const (
+	CountBytes     = "bytes"
+	CountRunes     = "runes"
+	CountGraphemes = "graphemes"
+)
+
+var (
+	ErrInvalidLocale = errors.New("invalid locale")
+	ErrUnknownUnit   = errors.New("unknown count unit")
+)
+
+// parseLocale parses an optional BCP-47 tag, the empty tag being language-neutral.
+func parseLocale(locale string) (language.Tag, error) {
+	if locale == "" {
+		return language.Und, nil
+	}
+	tag, err := language.Parse(locale)
+	if err != nil {
+		return language.Und, ErrInvalidLocale
+	}
+	return tag, nil
+}
+
+// caseString applies a caser built for the locale; casers are not safe for concurrent use.
+func caseString(s string, locale string, caser func(language.Tag, ...cases.Option) cases.Caser) (string, error) {
+	if s == "" {
+		return "", ErrEmpty
+	}
+	tag, err := parseLocale(locale)
+	if err != nil {
+		return "", err
+	}
+	return caser(tag).String(s), nil
+}
+
+func countString(s string, unit string) (int64, error) {
+	switch unit {
+	case "", CountBytes:
+		return int64(len(s)), nil
+	case CountRunes:
+		return int64(utf8.RuneCountInString(s)), nil
+	case CountGraphemes:
+		return int64(uniseg.GraphemeClusterCount(s)), nil
+	default:
+		return 0, ErrUnknownUnit
+	}
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+)
+
+func TestCaseString(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		caser  func(language.Tag, ...cases.Option) cases.Caser
+		s      string
+		locale string
+		want   string
+	}{
+		{"upper", cases.Upper, "istanbul", "", "ISTANBUL"},
+		{"upper", cases.Upper, "istanbul", "tr", "İSTANBUL"},
+		{"upper", cases.Upper, "ılık", "tr", "ILIK"},
+		{"upper", cases.Upper, "iğdır", "az", "İĞDIR"},
+		{"upper", cases.Upper, "straße", "", "STRASSE"},
+		{"upper", cases.Upper, "straße", "de", "STRASSE"},
+		{"lower", cases.Lower, "ISPARTA", "", "isparta"},
+		{"lower", cases.Lower, "ISPARTA", "tr", "ısparta"},
+		{"lower", cases.Lower, "İZMİR", "tr", "izmir"},
+		{"lower", cases.Lower, "İ", "", "i̇"},
+		{"title", cases.Title, "istanbul ılık", "tr", "İstanbul Ilık"},
+		{"title", cases.Title, "ijsselmeer", "nl", "IJsselmeer"},
+		{"title", cases.Title, "ijsselmeer", "", "Ijsselmeer"},
+	} {
+		got, err := caseString(tc.s, tc.locale, tc.caser)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.want, got, "%s %q in %q", tc.name, tc.s, tc.locale)
+	}
+
+	_, err := caseString("", "", cases.Upper)
+	assert.Equal(t, ErrEmpty, err)
+	_, err = caseString("a", "not a locale", cases.Upper)
+	assert.Equal(t, ErrInvalidLocale, err)
+}
+
+func TestCountString(t *testing.T) {
+	for _, tc := range []struct {
+		s       string
+		unit    string
+		want    int64
+		wantErr error
+	}{
+		{"straße", "", 7, nil},
+		{"straße", CountBytes, 7, nil},
+		{"straße", CountRunes, 6, nil},
+		{"straße", CountGraphemes, 6, nil},
+		{"é", CountRunes, 2, nil},
+		{"é", CountGraphemes, 1, nil},
+		{"👍🏽", CountRunes, 2, nil},
+		{"👍🏽", CountGraphemes, 1, nil},
+		{"👨‍👩‍👧", CountGraphemes, 1, nil},
+		{"🇹🇷🇩🇪", CountGraphemes, 2, nil},
+		{"", CountGraphemes, 0, nil},
+		{"abc", "words", 0, ErrUnknownUnit},
+	} {
+		got, err := countString(tc.s, tc.unit)
+		assert.Equal(t, tc.wantErr, err, "%q in %q", tc.s, tc.unit)
+		assert.Equal(t, tc.want, got, "%q in %q", tc.s, tc.unit)
+	}
+}
@@ -0,0 +1,122 @@
+package main
+
+import (
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"html"
+	"net/url"
+	"sort"
+	"unicode/utf8"
+
+	"golang.org/x/net/idna"
+)
+
+var (
+	ErrUnknownEncoding = errors.New("unknown encoding")
+	ErrMalformedInput  = errors.New("malformed input")
+)
+
+type codec struct {
+	encode func(string) (string, error)
+	decode func(string) (string, error)
+}
+
+func bytesCodec(encode func([]byte) string, decode func(string) ([]byte, error)) codec {
+	return codec{
+		encode: func(s string) (string, error) {
+			return encode([]byte(s)), nil
+		},
+		decode: func(s string) (string, error) {
+			b, err := decode(s)
+			if err != nil {
+				return "", err
+			}
+			if !utf8.Valid(b) {
+				return "", errors.New("decoded bytes are not valid UTF-8 text")
+			}
+			return string(b), nil
+		},
+	}
+}
+
+func infallible(f func(string) string) func(string) (string, error) {
+	return func(s string) (string, error) {
+		return f(s), nil
+	}
+}
+
+// codecs are the encodings supported by Encode and Decode.
+var codecs = map[string]codec{
+	"base64":       bytesCodec(base64.StdEncoding.EncodeToString, base64.StdEncoding.DecodeString),
+	"base64raw":    bytesCodec(base64.RawStdEncoding.EncodeToString, base64.RawStdEncoding.DecodeString),
+	"base64url":    bytesCodec(base64.URLEncoding.EncodeToString, base64.URLEncoding.DecodeString),
+	"base64urlraw": bytesCodec(base64.RawURLEncoding.EncodeToString, base64.RawURLEncoding.DecodeString),
+	"base32":       bytesCodec(base32.StdEncoding.EncodeToString, base32.StdEncoding.DecodeString),
+	"base32hex":    bytesCodec(base32.HexEncoding.EncodeToString, base32.HexEncoding.DecodeString),
+	"hex":          bytesCodec(hex.EncodeToString, hex.DecodeString),
+	"url":          {encode: infallible(url.QueryEscape), decode: url.QueryUnescape},
+	"path":         {encode: infallible(url.PathEscape), decode: url.PathUnescape},
+	"html":         {encode: infallible(html.EscapeString), decode: infallible(html.UnescapeString)},
+	"punycode":     {encode: idna.Lookup.ToASCII, decode: idna.Lookup.ToUnicode},
+}
+
+func encodingNames() []string {
+	names := make([]string, 0, len(codecs))
+	for name := range codecs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func encodeString(s string, encoding string) (string, error) {
+	c, ok := codecs[encoding]
+	if !ok {
+		return "", ErrUnknownEncoding
+	}
+	out, err := c.encode(s)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrMalformedInput, err)
+	}
+	return out, nil
+}
+
+func decodeString(s string, encoding string) (string, error) {
+	c, ok := codecs[encoding]
+	if !ok {
+		return "", ErrUnknownEncoding
+	}
+	out, err := c.decode(s)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrMalformedInput, err)
+	}
+	return out, nil
+}
+
+func checkEncodingParam(params map[string]string) error {
+	if _, ok := codecs[params["encoding"]]; !ok {
+		return ErrUnknownEncoding
+	}
+	return nil
+}
+
+func init() {
+	registerPipelineOp("encode", pipelineOp{
+		params: []string{"encoding"},
+		check:  checkEncodingParam,
+		apply: func(svc StringService, s string, params map[string]string) (string, error) {
+			return svc.Encode(s, params["encoding"])
+		},
+	})
+
+	registerPipelineOp("decode", pipelineOp{
+		params: []string{"encoding"},
+		check:  checkEncodingParam,
+		apply: func(svc StringService, s string, params map[string]string) (string, error) {
+			return svc.Decode(s, params["encoding"])
+		},
+	})
+}
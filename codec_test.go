@@ -0,0 +1,78 @@
+package main
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCodecRoundTrip(t *testing.T) {
+	inputs := []string{"", "hello world", "a+b=c&d/e?f#g", "<p class=\"x\">Tom & Jerry's</p>", "Grüße, 世界 👋🏽", "\x00\x01\n"}
+
+	for _, encoding := range encodingNames() {
+		if encoding == "punycode" {
+			continue
+		}
+		for _, s := range inputs {
+			encoded, err := encodeString(s, encoding)
+			assert.NoError(t, err, "%s %q", encoding, s)
+			decoded, err := decodeString(encoded, encoding)
+			assert.NoError(t, err, "%s %q", encoding, s)
+			assert.Equal(t, s, decoded, "%s %q", encoding, s)
+		}
+	}
+
+	for _, domain := range []string{"bücher.example", "münchen.de", "example.com", "日本語.jp"} {
+		encoded, err := encodeString(domain, "punycode")
+		assert.NoError(t, err, domain)
+		decoded, err := decodeString(encoded, "punycode")
+		assert.NoError(t, err, domain)
+		assert.Equal(t, domain, decoded)
+	}
+}
+
+func TestEncode(t *testing.T) {
+	for _, tc := range []struct {
+		encoding string
+		s        string
+		want     string
+	}{
+		{"base64", "hi?>", "aGk/Pg=="},
+		{"base64raw", "hi?>", "aGk/Pg"},
+		{"base64url", "hi?>", "aGk_Pg=="},
+		{"base64urlraw", "hi?>", "aGk_Pg"},
+		{"base32", "hi", "NBUQ===="},
+		{"base32hex", "hi", "D1KG===="},
+		{"hex", "hé", "68c3a9"},
+		{"url", "a b&c", "a+b%26c"},
+		{"path", "a b/c", "a%20b%2Fc"},
+		{"html", `<a href="x">`, "&lt;a href=&#34;x&#34;&gt;"},
+		{"punycode", "bücher.example", "xn--bcher-kva.example"},
+	} {
+		got, err := encodeString(tc.s, tc.encoding)
+		assert.NoError(t, err, tc.encoding)
+		assert.Equal(t, tc.want, got, tc.encoding)
+	}
+}
+
+func TestDecodeErrors(t *testing.T) {
+	for _, tc := range []struct {
+		encoding string
+		s        string
+	}{
+		{"base64", "not base64!"},
+		{"hex", "zz"},
+		{"hex", "ff"},
+		{"url", "%zz"},
+		{"punycode", "xn--a.com"},
+	} {
+		_, err := decodeString(tc.s, tc.encoding)
+		assert.True(t, errors.Is(err, ErrMalformedInput), "%s %q: %v", tc.encoding, tc.s, err)
+	}
+
+	_, err := encodeString("a", "rot13")
+	assert.Equal(t, ErrUnknownEncoding, err)
+	_, err = decodeString("a", "rot13")
+	assert.Equal(t, ErrUnknownEncoding, err)
+}
@@ -0,0 +1,254 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Diff modes, output formats and limits
+const (
+	DiffLines      = "line"
+	DiffChars      = "char"
+	DiffUnified    = "unified"
+	DiffEdits      = "edits"
+	maxDiffTokens  = 2000
+	maxDiffContext = 100
+	defaultContext = 3
+)
+
+var (
+	ErrUnknownMode   = errors.New("unknown mode, expected line or char")
+	ErrUnknownFormat = errors.New("unknown format, expected unified or edits")
+)
+
+// DiffOptions configures Diff. Mode defaults to line, Format to unified and
+// Context, the number of unchanged lines or grapheme clusters around each
+// hunk, 0 meaning 3.
+type DiffOptions struct {
+	Mode    string
+	Format  string
+	Context int
+}
+
+// DiffEdit is a run of lines or grapheme clusters that are equal, deleted or inserted.
+type DiffEdit struct {
+	Op   string `json:"op"`
+	Text string `json:"text"`
+}
+
+// DiffResult holds either a unified diff or an edit script, depending on the format.
+type DiffResult struct {
+	Unified string
+	Edits   []DiffEdit
+}
+
+type diffOp struct {
+	kind byte
+	text string
+}
+
+var diffOpNames = map[byte]string{' ': "equal", '-': "delete", '+': "insert"}
+
+func checkDiffOptions(opts DiffOptions) error {
+	switch opts.Mode {
+	case "", DiffLines, DiffChars:
+	default:
+		return ErrUnknownMode
+	}
+	switch opts.Format {
+	case "", DiffUnified, DiffEdits:
+	default:
+		return ErrUnknownFormat
+	}
+	return nil
+}
+
+// splitLines splits s after each newline, keeping a last line without one.
+func splitLines(s string) []string {
+	lines := strings.SplitAfter(s, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+func diffTokens(s string, mode string) ([]string, error) {
+	var tokens []string
+	if mode == DiffChars {
+		tokens = graphemes(s)
+	} else {
+		tokens = splitLines(s)
+	}
+	if len(tokens) > maxDiffTokens {
+		return nil, fmt.Errorf("%w: %d %ss, at most %d allowed", ErrInputTooLarge, len(tokens), mode, maxDiffTokens)
+	}
+	return tokens, nil
+}
+
+func diffStrings(a string, b string, opts DiffOptions) (DiffResult, error) {
+	if err := checkDiffOptions(opts); err != nil {
+		return DiffResult{}, err
+	}
+	if opts.Mode == "" {
+		opts.Mode = DiffLines
+	}
+	x, err := diffTokens(a, opts.Mode)
+	if err != nil {
+		return DiffResult{}, err
+	}
+	y, err := diffTokens(b, opts.Mode)
+	if err != nil {
+		return DiffResult{}, err
+	}
+
+	ops := diffSequences(x, y)
+	if opts.Format == DiffEdits {
+		return DiffResult{Edits: editScript(ops)}, nil
+	}
+	context := opts.Context
+	if context <= 0 {
+		context = defaultContext
+	}
+	return DiffResult{Unified: unifiedDiff(ops, context, opts.Mode)}, nil
+}
+
+// diffSequences computes a shortest edit script from the longest common
+// subsequence of x and y, after setting aside their common prefix and suffix.
+func diffSequences(x []string, y []string) []diffOp {
+	var prefix, suffix []diffOp
+	for len(x) > 0 && len(y) > 0 && x[0] == y[0] {
+		prefix = append(prefix, diffOp{' ', x[0]})
+		x, y = x[1:], y[1:]
+	}
+	for len(x) > 0 && len(y) > 0 && x[len(x)-1] == y[len(y)-1] {
+		suffix = append([]diffOp{{' ', x[len(x)-1]}}, suffix...)
+		x, y = x[:len(x)-1], y[:len(y)-1]
+	}
+
+	// lcs[i*w+j] is the length of the longest common subsequence of x[i:] and y[j:].
+	w := len(y) + 1
+	lcs := make([]uint16, (len(x)+1)*w)
+	for i := len(x) - 1; i >= 0; i-- {
+		for j := len(y) - 1; j >= 0; j-- {
+			if x[i] == y[j] {
+				lcs[i*w+j] = lcs[(i+1)*w+j+1] + 1
+			} else if lcs[(i+1)*w+j] >= lcs[i*w+j+1] {
+				lcs[i*w+j] = lcs[(i+1)*w+j]
+			} else {
+				lcs[i*w+j] = lcs[i*w+j+1]
+			}
+		}
+	}
+
+	ops := prefix
+	i, j := 0, 0
+	for i < len(x) || j < len(y) {
+		switch {
+		case i < len(x) && j < len(y) && x[i] == y[j]:
+			ops = append(ops, diffOp{' ', x[i]})
+			i, j = i+1, j+1
+		case j == len(y) || i < len(x) && lcs[(i+1)*w+j] >= lcs[i*w+j+1]:
+			ops = append(ops, diffOp{'-', x[i]})
+			i++
+		default:
+			ops = append(ops, diffOp{'+', y[j]})
+			j++
+		}
+	}
+	return append(ops, suffix...)
+}
+
+func editScript(ops []diffOp) []DiffEdit {
+	edits := []DiffEdit{}
+	for k := 0; k < len(ops); {
+		var text strings.Builder
+		kind := ops[k].kind
+		for ; k < len(ops) && ops[k].kind == kind; k++ {
+			text.WriteString(ops[k].text)
+		}
+		edits = append(edits, DiffEdit{diffOpNames[kind], text.String()})
+	}
+	return edits
+}
+
+// unifiedEscaper keeps every run of a character diff on one line.
+var unifiedEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, "\r", `\r`)
+
+// unifiedDiff formats operations as hunks with the given tokens of context,
+// in the format of diff -u. In char mode hunk ranges count grapheme clusters
+// and each run of equal, deleted or inserted clusters is one line, with
+// backslashes and line breaks escaped.
+func unifiedDiff(ops []diffOp, context int, mode string) string {
+	inHunk := make([]bool, len(ops))
+	changed := false
+	for k, op := range ops {
+		if op.kind == ' ' {
+			continue
+		}
+		changed = true
+		for c := max(0, k-context); c <= min(len(ops)-1, k+context); c++ {
+			inHunk[c] = true
+		}
+	}
+	if !changed {
+		return ""
+	}
+
+	var out strings.Builder
+	out.WriteString("--- a\n+++ b\n")
+	line := [2]int{}
+	for k := 0; k < len(ops); {
+		if !inHunk[k] {
+			line[0]++
+			line[1]++
+			k++
+			continue
+		}
+
+		end := k
+		count := [2]int{}
+		for ; end < len(ops) && inHunk[end]; end++ {
+			if ops[end].kind != '+' {
+				count[0]++
+			}
+			if ops[end].kind != '-' {
+				count[1]++
+			}
+		}
+		fmt.Fprintf(&out, "@@ -%s +%s @@\n", hunkRange(line[0], count[0]), hunkRange(line[1], count[1]))
+		for mode == DiffChars && k < end {
+			kind := ops[k].kind
+			var run strings.Builder
+			for ; k < end && ops[k].kind == kind; k++ {
+				run.WriteString(ops[k].text)
+			}
+			out.WriteByte(kind)
+			out.WriteString(unifiedEscaper.Replace(run.String()))
+			out.WriteByte('\n')
+		}
+		for ; k < end; k++ {
+			out.WriteByte(ops[k].kind)
+			out.WriteString(ops[k].text)
+			if !strings.HasSuffix(ops[k].text, "\n") {
+				out.WriteString("\n\\ No newline at end of file\n")
+			}
+		}
+		line[0] += count[0]
+		line[1] += count[1]
+	}
+	return out.String()
+}
+
+// hunkRange formats the 0-based start and length of a hunk side; an empty
+// side is reported at the line before it.
+func hunkRange(start int, count int) string {
+	switch count {
+	case 0:
+		return fmt.Sprintf("%d,0", start)
+	case 1:
+		return fmt.Sprintf("%d", start+1)
+	default:
+		return fmt.Sprintf("%d,%d", start+1, count)
+	}
+}
@@ -2,13 +2,15 @@ package main
 
 import (
 	"context"
+	"time"
+
 	"github.com/go-kit/kit/endpoint"
 )
 
 func makeUppercaseEndpoint(svc StringService) endpoint.Endpoint {
 	return func(_ context.Context, request interface{}) (interface{}, error) {
 		req := request.(uppercaseRequest)
-		v, err := svc.Uppercase(req.S)
+		v, err := svc.Uppercase(req.S, req.Locale)
 		if err != nil {
 			return uppercaseResponse{v, err.Error()}, nil
 		}
@@ -17,12 +19,358 @@ func makeUppercaseEndpoint(svc StringService) endpoint.Endpoint {
 	}
 }
 
+func makeLowercaseEndpoint(svc StringService) endpoint.Endpoint {
+	return func(_ context.Context, request interface{}) (interface{}, error) {
+		req := request.(lowercaseRequest)
+		v, err := svc.Lowercase(req.S, req.Locale)
+		if err != nil {
+			return lowercaseResponse{v, err.Error()}, nil
+		}
+
+		return lowercaseResponse{v, ""}, nil
+	}
+}
+
+func makeTitleCaseEndpoint(svc StringService) endpoint.Endpoint {
+	return func(_ context.Context, request interface{}) (interface{}, error) {
+		req := request.(titleCaseRequest)
+		v, err := svc.TitleCase(req.S, req.Locale)
+		if err != nil {
+			return titleCaseResponse{v, err.Error()}, nil
+		}
+
+		return titleCaseResponse{v, ""}, nil
+	}
+}
+
 func makeCountEndpoint(svc StringService) endpoint.Endpoint {
 	return func(_ context.Context, request interface{}) (interface{}, error) {
 		req := request.(countRequest)
-		v := svc.Count(req.S)
+		v, err := svc.Count(req.S, req.Unit)
+		if err != nil {
+			return countResponse{v, err.Error()}, nil
+		}
+
+		return countResponse{v, ""}, nil
+	}
+}
+
+func makeNormalizeEndpoint(svc StringService) endpoint.Endpoint {
+	return func(_ context.Context, request interface{}) (interface{}, error) {
+		req := request.(normalizeRequest)
+		v, err := svc.Normalize(req.S, NormalizeOptions{
+			Form:            req.Form,
+			CaseFold:        req.CaseFold,
+			StripDiacritics: req.StripDiacritics,
+		})
+		if err != nil {
+			return normalizeResponse{v, err.Error()}, nil
+		}
+
+		return normalizeResponse{v, ""}, nil
+	}
+}
+
+func makeAnalyzeEndpoint(svc StringService) endpoint.Endpoint {
+	return func(_ context.Context, request interface{}) (interface{}, error) {
+		req := request.(analyzeRequest)
+		stats := svc.Analyze(req.S)
+
+		return analyzeResponse{stats}, nil
+	}
+}
+
+func makeBatchEndpoint(svc StringService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(batchRequest)
+		results := runBatch(ctx, svc, req.Items, batchWorkers)
+
+		return batchResponse{results}, nil
+	}
+}
+
+func makeTransformEndpoint(svc StringService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(transformRequest)
+		result := applyBatchItem(ctx, svc, batchItem(req))
+
+		return transformResponse(result), nil
+	}
+}
+
+func makePipelineEndpoint(svc StringService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(pipelineRequest)
+		result, err := svc.Pipeline(ctx, req.S, req.Steps, req.Intermediate)
+		if err != nil {
+			return pipelineResponse{Err: err.Error()}, nil
+		}
+
+		return pipelineResponse{result.Output, result.Intermediate, ""}, nil
+	}
+}
+
+func makeReplaceEndpoint(svc StringService) endpoint.Endpoint {
+	return func(_ context.Context, request interface{}) (interface{}, error) {
+		req := request.(replaceRequest)
+		v, err := svc.Replace(req.S, ReplaceOptions{
+			Pattern:     req.Pattern,
+			Replacement: req.Replacement,
+			Flags:       req.Flags,
+		})
+		if err != nil {
+			return replaceResponse{v, err.Error()}, nil
+		}
+
+		return replaceResponse{v, ""}, nil
+	}
+}
+
+func makeExtractEndpoint(svc StringService) endpoint.Endpoint {
+	return func(_ context.Context, request interface{}) (interface{}, error) {
+		req := request.(extractRequest)
+		matches, err := svc.Extract(req.S, ExtractOptions{
+			Pattern: req.Pattern,
+			Flags:   req.Flags,
+			Limit:   req.Limit,
+		})
+		if err != nil {
+			return extractResponse{Err: err.Error()}, nil
+		}
+
+		return extractResponse{matches, ""}, nil
+	}
+}
+
+func makeEncodeEndpoint(svc StringService) endpoint.Endpoint {
+	return func(_ context.Context, request interface{}) (interface{}, error) {
+		req := request.(encodeStringRequest)
+		v, err := svc.Encode(req.S, req.Encoding)
+		if err != nil {
+			return encodeStringResponse{v, err.Error()}, nil
+		}
+
+		return encodeStringResponse{v, ""}, nil
+	}
+}
+
+func makeDecodeEndpoint(svc StringService) endpoint.Endpoint {
+	return func(_ context.Context, request interface{}) (interface{}, error) {
+		req := request.(decodeStringRequest)
+		v, err := svc.Decode(req.S, req.Encoding)
+		if err != nil {
+			return decodeStringResponse{v, err.Error()}, nil
+		}
+
+		return decodeStringResponse{v, ""}, nil
+	}
+}
+
+func makeHashEndpoint(svc StringService) endpoint.Endpoint {
+	return func(_ context.Context, request interface{}) (interface{}, error) {
+		req := request.(hashRequest)
+		v, err := svc.Hash(req.S, HashOptions{
+			Algorithm: req.Algorithm,
+			Output:    req.Output,
+			Key:       req.Key,
+		})
+		if err != nil {
+			return hashResponse{v, err.Error()}, nil
+		}
+
+		return hashResponse{v, ""}, nil
+	}
+}
 
-		return countResponse{v}, nil
+func makeCompareEndpoint(svc StringService) endpoint.Endpoint {
+	return func(_ context.Context, request interface{}) (interface{}, error) {
+		req := request.(compareRequest)
+		result, err := svc.Compare(req.A, req.B)
+		if err != nil {
+			return compareResponse{Err: err.Error()}, nil
+		}
+
+		return compareResponse{result, ""}, nil
+	}
+}
+
+func makeDiffEndpoint(svc StringService) endpoint.Endpoint {
+	return func(_ context.Context, request interface{}) (interface{}, error) {
+		req := request.(diffRequest)
+		result, err := svc.Diff(req.A, req.B, DiffOptions{
+			Mode:    req.Mode,
+			Format:  req.Format,
+			Context: req.Context,
+		})
+		if err != nil {
+			return diffResponse{Err: err.Error()}, nil
+		}
+
+		return diffResponse{result.Unified, result.Edits, ""}, nil
+	}
+}
+
+func makeSlugifyEndpoint(svc StringService) endpoint.Endpoint {
+	return func(_ context.Context, request interface{}) (interface{}, error) {
+		req := request.(slugifyRequest)
+		v, err := svc.Slugify(req.S, SlugOptions{
+			Separator: req.Separator,
+			MaxLength: req.MaxLength,
+			Existing:  req.Existing,
+		})
+		if err != nil {
+			return slugifyResponse{v, err.Error()}, nil
+		}
+
+		return slugifyResponse{v, ""}, nil
+	}
+}
+
+func makeTokenizeEndpoint(svc StringService) endpoint.Endpoint {
+	return func(_ context.Context, request interface{}) (interface{}, error) {
+		req := request.(tokenizeRequest)
+		tokens, err := svc.Tokenize(req.S, TokenizeOptions{
+			Unit:      req.Unit,
+			N:         req.N,
+			Lowercase: req.Lowercase,
+			StopWords: req.StopWords,
+		})
+		if err != nil {
+			return tokenizeResponse{Err: err.Error()}, nil
+		}
+
+		return tokenizeResponse{tokens, ""}, nil
+	}
+}
+
+func makeRenderEndpoint(svc StringService) endpoint.Endpoint {
+	return func(_ context.Context, request interface{}) (interface{}, error) {
+		req := request.(renderRequest)
+		v, err := svc.Render(req.Template, req.Data)
+		if err != nil {
+			return renderResponse{v, err.Error()}, nil
+		}
+
+		return renderResponse{v, ""}, nil
+	}
+}
+
+func makeRedactEndpoint(svc StringService) endpoint.Endpoint {
+	return func(_ context.Context, request interface{}) (interface{}, error) {
+		req := request.(redactRequest)
+		result, err := svc.Redact(req.S, RedactOptions{
+			Detectors: req.Detectors,
+			Style:     req.Style,
+		})
+		if err != nil {
+			return redactResponse{Err: err.Error()}, nil
+		}
+
+		return redactResponse{result.Output, result.Findings, ""}, nil
+	}
+}
+
+func makeDetectLanguageEndpoint(svc StringService) endpoint.Endpoint {
+	return func(_ context.Context, request interface{}) (interface{}, error) {
+		req := request.(detectLanguageRequest)
+		result := svc.DetectLanguage(req.S)
+
+		return detectLanguageResponse{result}, nil
+	}
+}
+
+func makeTruncateEndpoint(svc StringService) endpoint.Endpoint {
+	return func(_ context.Context, request interface{}) (interface{}, error) {
+		req := request.(truncateRequest)
+		v, err := svc.Truncate(req.S, TruncateOptions{
+			Unit:     req.Unit,
+			Max:      req.Max,
+			Ellipsis: req.Ellipsis,
+		})
+		if err != nil {
+			return truncateResponse{v, err.Error()}, nil
+		}
+
+		return truncateResponse{v, ""}, nil
+	}
+}
+
+func makeWrapEndpoint(svc StringService) endpoint.Endpoint {
+	return func(_ context.Context, request interface{}) (interface{}, error) {
+		req := request.(wrapRequest)
+		v, err := svc.Wrap(req.S, req.Width)
+		if err != nil {
+			return wrapResponse{v, err.Error()}, nil
+		}
+
+		return wrapResponse{v, ""}, nil
+	}
+}
+
+func makeJobResponse(job Job, err error) jobResponse {
+	if err != nil {
+		return jobResponse{Err: err.Error()}
+	}
+	response := jobResponse{
+		ID:        job.ID,
+		Status:    job.Status,
+		Done:      job.Done,
+		Total:     job.Total,
+		Results:   job.Results,
+		Reason:    job.Error,
+		CreatedAt: job.Created.UTC().Format(time.RFC3339),
+		UpdatedAt: job.Updated.UTC().Format(time.RFC3339),
+	}
+	if job.Pipeline != nil {
+		response.Pipeline = &pipelineResponse{V: job.Pipeline.Output, Intermediate: job.Pipeline.Intermediate}
+	}
+	return response
+}
+
+func makeSubmitJobEndpoint(jobs JobService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(submitJobRequest)
+		spec := JobSpec{Pipeline: req.Pipeline, CallbackURL: req.CallbackURL}
+		if req.Batch != nil {
+			spec.Batch = req.Batch.Items
+		}
+		return makeJobResponse(jobs.Submit(principalFromContext(ctx), spec)), nil
+	}
+}
+
+func makeGetJobEndpoint(jobs JobService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(jobRequest)
+		return makeJobResponse(jobs.Get(principalFromContext(ctx), req.ID)), nil
+	}
+}
+
+func makeCancelJobEndpoint(jobs JobService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(jobRequest)
+		return makeJobResponse(jobs.Cancel(principalFromContext(ctx), req.ID)), nil
+	}
+}
+
+func makeJobDeliveriesEndpoint(jobs JobService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(jobRequest)
+		attempts, err := jobs.Deliveries(principalFromContext(ctx), req.ID)
+		if err != nil {
+			return jobDeliveriesResponse{Deliveries: []deliveryAttempt{}, Err: err.Error()}, nil
+		}
+
+		deliveries := make([]deliveryAttempt, len(attempts))
+		for i, a := range attempts {
+			deliveries[i] = deliveryAttempt{
+				Attempt:    a.Attempt,
+				Time:       a.Time.UTC().Format(time.RFC3339Nano),
+				StatusCode: a.StatusCode,
+				Error:      a.Error,
+				DurationMs: int64(a.Duration / time.Millisecond),
+			}
+		}
+		return jobDeliveriesResponse{Deliveries: deliveries}, nil
 	}
 }
 
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"hash"
+	"hash/crc32"
+	"sort"
+
+	"github.com/cespare/xxhash/v2"
+	"golang.org/x/crypto/blake2b"
+	"golang.org/x/crypto/sha3"
+)
+
+var (
+	ErrUnknownAlgorithm = errors.New("unknown algorithm")
+	ErrUnknownOutput    = errors.New("unknown output, expected hex or base64")
+	ErrUnknownKey       = errors.New("unknown key")
+	ErrHMACUnsupported  = errors.New("algorithm cannot be used with a key")
+)
+
+// HashOptions configures Hash. Output defaults to hex; a non-empty Key names
+// a server-side key and turns the digest into an HMAC.
+type HashOptions struct {
+	Algorithm string
+	Output    string
+	Key       string
+}
+
+// hashAlgorithm builds a hash.Hash; keyed reports whether it may back an HMAC.
+type hashAlgorithm struct {
+	new   func() hash.Hash
+	keyed bool
+}
+
+func mustHash(f func([]byte) (hash.Hash, error)) func() hash.Hash {
+	return func() hash.Hash {
+		h, err := f(nil)
+		if err != nil {
+			panic(err)
+		}
+		return h
+	}
+}
+
+// xxhash64 is a hash.Hash64 whose Sum is big-endian, like its canonical hex form.
+type xxhash64 struct {
+	*xxhash.Digest
+}
+
+func (d xxhash64) Sum(b []byte) []byte {
+	var sum [8]byte
+	binary.BigEndian.PutUint64(sum[:], d.Sum64())
+	return append(b, sum[:]...)
+}
+
+// hashAlgorithms are the algorithms supported by Hash. Checksums such as
+// xxhash64 and crc32 are not cryptographic and cannot be keyed.
+var hashAlgorithms = map[string]hashAlgorithm{
+	"sha256":      {sha256.New, true},
+	"sha512":      {sha512.New, true},
+	"sha3-256":    {sha3.New256, true},
+	"sha3-512":    {sha3.New512, true},
+	"blake2b-256": {mustHash(blake2b.New256), true},
+	"blake2b-512": {mustHash(blake2b.New512), true},
+	"xxhash64":    {func() hash.Hash { return xxhash64{xxhash.New()} }, false},
+	"crc32":       {func() hash.Hash { return crc32.NewIEEE() }, false},
+}
+
+var hashOutputs = map[string]func([]byte) string{
+	"hex":    hex.EncodeToString,
+	"base64": base64.StdEncoding.EncodeToString,
+}
+
+func hashAlgorithmNames() []string {
+	names := make([]string, 0, len(hashAlgorithms))
+	for name := range hashAlgorithms {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func checkHashOptions(opts HashOptions) error {
+	alg, ok := hashAlgorithms[opts.Algorithm]
+	if !ok {
+		return ErrUnknownAlgorithm
+	}
+	if _, ok := hashOutputs[opts.Output]; opts.Output != "" && !ok {
+		return ErrUnknownOutput
+	}
+	if opts.Key != "" && !alg.keyed {
+		return ErrHMACUnsupported
+	}
+	return nil
+}
+
+// hashString digests s. Keys are looked up by name and never leave the server.
+func hashString(s string, opts HashOptions, keys map[string][]byte) (string, error) {
+	if err := checkHashOptions(opts); err != nil {
+		return "", err
+	}
+	alg := hashAlgorithms[opts.Algorithm]
+
+	h := alg.new()
+	if opts.Key != "" {
+		key, ok := keys[opts.Key]
+		if !ok {
+			return "", ErrUnknownKey
+		}
+		h = hmac.New(alg.new, key)
+	}
+	h.Write([]byte(s))
+
+	output := hashOutputs["hex"]
+	if opts.Output != "" {
+		output = hashOutputs[opts.Output]
+	}
+	return output(h.Sum(nil)), nil
+}
+
+func init() {
+	registerPipelineOp("hash", pipelineOp{
+		params: []string{"algorithm", "output", "key"},
+		check: func(params map[string]string) error {
+			return checkHashOptions(HashOptions{params["algorithm"], params["output"], params["key"]})
+		},
+		apply: func(svc StringService, s string, params map[string]string) (string, error) {
+			return svc.Hash(s, HashOptions{params["algorithm"], params["output"], params["key"]})
+		},
+	})
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHash(t *testing.T) {
+	keys := map[string][]byte{"default": []byte("hmac_secret_key"), "short": []byte("k")}
+
+	for _, tc := range []struct {
+		opts HashOptions
+		want string
+		err  error
+	}{
+		{HashOptions{Algorithm: "sha256"}, "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad", nil},
+		{HashOptions{Algorithm: "sha512"}, "ddaf35a193617abacc417349ae20413112e6fa4e89a97ea20a9eeee64b55d39a2192992a274fc1a836ba3c23a3feebbd454d4423643ce80e2a9ac94fa54ca49f", nil},
+		{HashOptions{Algorithm: "sha3-256"}, "3a985da74fe225b2045c172d6bd390bd855f086e3e9d525b46bfe24511431532", nil},
+		{HashOptions{Algorithm: "sha3-512"}, "b751850b1a57168a5693cd924b6b096e08f621827444f70d884f5d0240d2712e10e116e9192af3c91a7ec57647e3934057340b4cf408d5a56592f8274eec53f0", nil},
+		{HashOptions{Algorithm: "blake2b-256"}, "bddd813c634239723171ef3fee98579b94964e3bb1cb3e427262c8c068d52319", nil},
+		{HashOptions{Algorithm: "blake2b-512"}, "ba80a53f981c4d0d6a2797b69f12f6e94c212f14685ac4b74b12bb6fdbffa2d17d87c5392aab792dc252d5de4533cc9518d38aa8dbf1925ab92386edd4009923", nil},
+		{HashOptions{Algorithm: "xxhash64"}, "44bc2cf5ad770999", nil},
+		{HashOptions{Algorithm: "crc32"}, "352441c2", nil},
+		{HashOptions{Algorithm: "sha256", Output: "base64"}, "ungWv48Bz+pBQUDeXa4iI7ADYaOWF3qctBD/YfIAFa0=", nil},
+		{HashOptions{Algorithm: "sha256", Key: "default"}, "092d18eb5d1d4f0611bf987ba756b6b8e4d705721ac8dffc79b725a0190c6d64", nil},
+		{HashOptions{Algorithm: "blake2b-256", Key: "short"}, "16e431da863f0480b5bd025568072d72ada737abd398e1357e403622233c1add", nil},
+		{HashOptions{Algorithm: "md5"}, "", ErrUnknownAlgorithm},
+		{HashOptions{Algorithm: "sha256", Output: "base32"}, "", ErrUnknownOutput},
+		{HashOptions{Algorithm: "sha256", Key: "missing"}, "", ErrUnknownKey},
+		{HashOptions{Algorithm: "crc32", Key: "default"}, "", ErrHMACUnsupported},
+	} {
+		got, err := hashString("abc", tc.opts, keys)
+		assert.Equal(t, tc.err, err, "%+v", tc.opts)
+		assert.Equal(t, tc.want, got, "%+v", tc.opts)
+	}
+}
@@ -0,0 +1,455 @@
+package main
+
+import (
+	"bytes"
+	"container/list"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/fnaumov/gokit-stringsvc/pb"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Idempotency keys are sent in this header, or in the equivalent lowercase
+// gRPC metadata, and replayed responses are marked with the second one.
+const (
+	idempotencyKeyHeader    = "Idempotency-Key"
+	idempotentReplayHeader  = "Idempotent-Replayed"
+	maxIdempotencyKeyLength = 255
+)
+
+// idempotencyConflictError is returned when a key is reused with a different
+// request. HTTP uses 422 like the IETF Idempotency-Key draft; gRPC has no such
+// status, FailedPrecondition is the closest since the call cannot succeed
+// until the client changes the key.
+type idempotencyConflictError struct{}
+
+func (idempotencyConflictError) Error() string {
+	return "idempotency key already used for a different request"
+}
+
+// StatusCode implements httptransport.StatusCoder.
+func (idempotencyConflictError) StatusCode() int {
+	return http.StatusUnprocessableEntity
+}
+
+func (e idempotencyConflictError) GRPCStatus() *status.Status {
+	return status.New(codes.FailedPrecondition, e.Error())
+}
+
+// idempotentResult is a stored outcome; size approximates its memory in bytes.
+type idempotentResult interface {
+	size() int
+}
+
+// idempotencyStore remembers the result of the first request made with a
+// key for a window. It holds at most maxEntries entries and maxBytes of keys
+// and results, evicting the least recently used entries first.
+type idempotencyStore struct {
+	mtx        sync.Mutex
+	window     time.Duration
+	maxEntries int
+	maxBytes   int
+	bytes      int
+	order      *list.List
+	entries    map[string]*idempotencyEntry
+	now        func() time.Time
+}
+
+type idempotencyEntry struct {
+	key         string
+	fingerprint string
+	expires     time.Time
+	done        chan struct{}
+	ok          bool
+	result      idempotentResult
+	size        int
+	el          *list.Element
+}
+
+func newIdempotencyStore(window time.Duration, maxEntries int, maxBytes int) *idempotencyStore {
+	return &idempotencyStore{
+		window:     window,
+		maxEntries: maxEntries,
+		maxBytes:   maxBytes,
+		order:      list.New(),
+		entries:    map[string]*idempotencyEntry{},
+		now:        time.Now,
+	}
+}
+
+// begin returns the entry for key, creating it when there is none; the
+// caller owns a new entry and must finish or abandon it.
+func (s *idempotencyStore) begin(key string, fingerprint string) (*idempotencyEntry, bool) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	now := s.now()
+	if e, ok := s.entries[key]; ok {
+		if !now.After(e.expires) {
+			s.order.MoveToBack(e.el)
+			return e, false
+		}
+		s.remove(e)
+	}
+	for el := s.order.Front(); el != nil && now.After(el.Value.(*idempotencyEntry).expires); el = s.order.Front() {
+		s.remove(el.Value.(*idempotencyEntry))
+	}
+
+	e := &idempotencyEntry{key: key, fingerprint: fingerprint, expires: now.Add(s.window), done: make(chan struct{})}
+	e.size = len(key) + len(fingerprint)
+	e.el = s.order.PushBack(e)
+	s.entries[key] = e
+	s.bytes += e.size
+	s.evict()
+	return e, true
+}
+
+func (s *idempotencyStore) finish(e *idempotencyEntry, result idempotentResult) {
+	s.mtx.Lock()
+	e.result, e.ok = result, true
+	if e.el != nil {
+		size := result.size()
+		e.size += size
+		s.bytes += size
+		s.evict()
+	}
+	s.mtx.Unlock()
+	close(e.done)
+}
+
+// abandon forgets an entry whose result must not be replayed, letting the
+// next request with its key run again.
+func (s *idempotencyStore) abandon(e *idempotencyEntry) {
+	s.mtx.Lock()
+	s.remove(e)
+	s.mtx.Unlock()
+	close(e.done)
+}
+
+// remove drops e unless it was already evicted. s.mtx must be held.
+func (s *idempotencyStore) remove(e *idempotencyEntry) {
+	if e.el == nil {
+		return
+	}
+	s.order.Remove(e.el)
+	e.el = nil
+	delete(s.entries, e.key)
+	s.bytes -= e.size
+}
+
+// evict removes the least recently used entries until the store is within
+// its limits. Waiters on an evicted entry still receive its result.
+func (s *idempotencyStore) evict() {
+	for s.order.Len() > s.maxEntries || (s.bytes > s.maxBytes && s.order.Len() > 0) {
+		s.remove(s.order.Front().Value.(*idempotencyEntry))
+	}
+}
+
+// do runs fn for the first request with key and returns its result to later
+// requests with the same fingerprint, which wait while fn is running. The
+// result is only kept when fn says so. replayed reports a stored result.
+func (s *idempotencyStore) do(ctx context.Context, key string, fingerprint string, fn func() (idempotentResult, bool)) (result idempotentResult, replayed bool, err error) {
+	for {
+		e, owner := s.begin(key, fingerprint)
+		if !owner {
+			if e.fingerprint != fingerprint {
+				return nil, false, idempotencyConflictError{}
+			}
+			select {
+			case <-e.done:
+			case <-ctx.Done():
+				return nil, false, ctx.Err()
+			}
+			if e.ok {
+				return e.result, true, nil
+			}
+			continue
+		}
+
+		finished := false
+		defer func() {
+			if !finished {
+				s.abandon(e)
+			}
+		}()
+		result, keep := fn()
+		if keep {
+			s.finish(e, result)
+			finished = true
+		}
+		return result, false, nil
+	}
+}
+
+// tokenUsername returns the user of a valid bearer token.
+func tokenUsername(authorization string) string {
+	parts := strings.Fields(authorization)
+	if len(parts) != 2 || !strings.EqualFold(parts[0], "Bearer") {
+		return ""
+	}
+	claims := &customClaims{}
+	_, err := jwt.ParseWithClaims(parts[1], claims, func(token *jwt.Token) (interface{}, error) {
+		if token.Method != jwt.SigningMethodHS256 {
+			return nil, fmt.Errorf("unexpected signing method %s", token.Method.Alg())
+		}
+		return authConfig.key, nil
+	})
+	if err != nil {
+		return ""
+	}
+	return claims.Username
+}
+
+func checkIdempotencyKey(key string) error {
+	if len(key) > maxIdempotencyKeyLength {
+		return validationError{{idempotencyKeyHeader, fmt.Sprintf("exceeds %d bytes", maxIdempotencyKeyLength)}}
+	}
+	return nil
+}
+
+// Idempotent HTTP requests
+
+// httpPrincipal identifies who made a request, "" meaning it cannot be keyed.
+type httpPrincipal func(r *http.Request, body []byte) string
+
+func bearerPrincipal(r *http.Request, _ []byte) string {
+	return tokenUsername(r.Header.Get("Authorization"))
+}
+
+// authPrincipal is the username an auth request claims, only verified once
+// the request succeeds.
+func authPrincipal(_ *http.Request, body []byte) string {
+	var request authRequest
+	_ = json.Unmarshal(body, &request)
+	return request.Username
+}
+
+// Statuses of the responses idempotentHTTP stores: anything but server
+// errors, which retries should run again, or only successes when the
+// principal is claimed rather than verified, so that a failed attempt
+// cannot hold a key for the real user.
+func notServerError(status int) bool {
+	return status < http.StatusInternalServerError
+}
+
+func succeeded(status int) bool {
+	return status < http.StatusMultipleChoices
+}
+
+type recordedResponse struct {
+	status int
+	header http.Header
+	body   []byte
+}
+
+func (res recordedResponse) size() int {
+	n := len(res.body)
+	for k, v := range res.header {
+		n += len(k)
+		for _, s := range v {
+			n += len(s)
+		}
+	}
+	return n
+}
+
+func (res recordedResponse) replay(w http.ResponseWriter) {
+	for k, v := range res.header {
+		if k != requestIDHeader {
+			w.Header()[k] = v
+		}
+	}
+	w.Header().Set(idempotentReplayHeader, "true")
+	w.WriteHeader(res.status)
+	_, _ = w.Write(res.body)
+}
+
+// responseRecorder copies what is written to the client.
+type responseRecorder struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (w *responseRecorder) WriteHeader(status int) {
+	if w.status == 0 {
+		w.status = status
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *responseRecorder) Write(p []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	w.body.Write(p)
+	return w.ResponseWriter.Write(p)
+}
+
+// errorReader fails reads with err, handing a body read error on to the decoder.
+type errorReader struct {
+	err error
+}
+
+func (r errorReader) Read([]byte) (int, error) {
+	return 0, r.err
+}
+
+// idempotentHTTP replays the response to the first request carrying an
+// Idempotency-Key for the same principal, when keep accepts its status.
+func idempotentHTTP(store *idempotencyStore, principal httpPrincipal, keep func(status int) bool) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := r.Header.Get(idempotencyKeyHeader)
+			if key == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if err := checkIdempotencyKey(key); err != nil {
+				encodeError(r.Context(), err, w)
+				return
+			}
+
+			body, err := ioutil.ReadAll(r.Body)
+			if err != nil {
+				r.Body = ioutil.NopCloser(io.MultiReader(bytes.NewReader(body), errorReader{err}))
+				next.ServeHTTP(w, r)
+				return
+			}
+			r.Body = ioutil.NopCloser(bytes.NewReader(body))
+			who := principal(r, body)
+			if who == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			result, replayed, err := store.do(r.Context(), cacheKey("http", who, key), cacheKey(r.Method+" "+r.URL.Path, string(body)), func() (idempotentResult, bool) {
+				rec := &responseRecorder{ResponseWriter: w}
+				next.ServeHTTP(rec, r)
+				return recordedResponse{rec.status, w.Header().Clone(), rec.body.Bytes()}, keep(rec.status)
+			})
+			switch {
+			case err == context.Canceled || err == context.DeadlineExceeded:
+			case err != nil:
+				encodeError(r.Context(), err, w)
+			case replayed:
+				result.(recordedResponse).replay(w)
+			}
+		})
+	}
+}
+
+// Idempotent gRPC calls
+
+// grpcIdempotentMethod is a method accepting an idempotency-key, with the
+// principal of its requests. Claimed principals are only verified by a
+// successful call, and only successes are stored for them.
+type grpcIdempotentMethod struct {
+	principal func(ctx context.Context, req interface{}) string
+	claimed   bool
+}
+
+var grpcIdempotentMethods = map[string]grpcIdempotentMethod{
+	"/pb.StringService/Batch":     {metadataPrincipal, false},
+	"/pb.StringService/SubmitJob": {metadataPrincipal, false},
+	"/pb.StringService/Auth":      {authRequestPrincipal, true},
+}
+
+func authRequestPrincipal(_ context.Context, req interface{}) string {
+	return req.(*pb.AuthRequest).Username
+}
+
+func metadataPrincipal(ctx context.Context, _ interface{}) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get("authorization"); len(values) > 0 {
+		return tokenUsername(values[0])
+	}
+	return ""
+}
+
+type recordedCall struct {
+	resp interface{}
+	err  error
+}
+
+func (call recordedCall) size() int {
+	n := 0
+	if m, ok := call.resp.(proto.Message); ok {
+		n += proto.Size(m)
+	}
+	if call.err != nil {
+		n += len(call.err.Error())
+	}
+	return n
+}
+
+// idempotencyUnaryInterceptor is the gRPC counterpart of idempotentHTTP,
+// keeping every outcome but internal and transient errors. Auth calls are
+// stored in authStore, whose window does not outlast the tokens issued.
+func idempotencyUnaryInterceptor(store *idempotencyStore, authStore *idempotencyStore) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		method, ok := grpcIdempotentMethods[info.FullMethod]
+		if !ok {
+			return handler(ctx, req)
+		}
+		calls := store
+		if method.claimed {
+			calls = authStore
+		}
+		md, _ := metadata.FromIncomingContext(ctx)
+		keys := md.Get(strings.ToLower(idempotencyKeyHeader))
+		if len(keys) == 0 || keys[0] == "" {
+			return handler(ctx, req)
+		}
+		if err := checkIdempotencyKey(keys[0]); err != nil {
+			return nil, err
+		}
+		who := method.principal(ctx, req)
+		if who == "" {
+			return handler(ctx, req)
+		}
+		message, err := proto.Marshal(req.(proto.Message))
+		if err != nil {
+			return handler(ctx, req)
+		}
+
+		result, replayed, err := calls.do(ctx, cacheKey("grpc", who, keys[0]), cacheKey(info.FullMethod, string(message)), func() (idempotentResult, bool) {
+			resp, err := handler(ctx, req)
+			if method.claimed {
+				return recordedCall{resp, err}, err == nil
+			}
+			switch status.Code(err) {
+			case codes.Canceled, codes.DeadlineExceeded, codes.Unknown, codes.Internal, codes.Unavailable, codes.DataLoss:
+				return recordedCall{resp, err}, false
+			}
+			return recordedCall{resp, err}, true
+		})
+		switch err {
+		case nil:
+		case context.Canceled:
+			return nil, status.Error(codes.Canceled, err.Error())
+		case context.DeadlineExceeded:
+			return nil, status.Error(codes.DeadlineExceeded, err.Error())
+		default:
+			return nil, err
+		}
+		call := result.(recordedCall)
+		if replayed {
+			_ = grpc.SetHeader(ctx, metadata.Pairs(strings.ToLower(idempotentReplayHeader), "true"))
+		}
+		return call.resp, call.err
+	}
+}
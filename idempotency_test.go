@@ -0,0 +1,252 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/fnaumov/gokit-stringsvc/pb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// uppercaseCountingService counts the strings it uppercases.
+type uppercaseCountingService struct {
+	StringService
+	calls int32
+}
+
+func (s *uppercaseCountingService) Uppercase(str string, locale string) (string, error) {
+	atomic.AddInt32(&s.calls, 1)
+	return s.StringService.Uppercase(str, locale)
+}
+
+// storedCount is a stored result of the given size.
+type storedCount struct {
+	n     int32
+	bytes int
+}
+
+func (c storedCount) size() int {
+	return c.bytes
+}
+
+func TestIdempotencyStore(t *testing.T) {
+	store := newIdempotencyStore(time.Minute, 10, 1<<10)
+	now := time.Unix(0, 0)
+	store.now = func() time.Time { return now }
+
+	var calls int32
+	release := make(chan struct{})
+	run := func() (idempotentResult, bool) {
+		<-release
+		return storedCount{atomic.AddInt32(&calls, 1), 1}, true
+	}
+
+	var wg sync.WaitGroup
+	results := make([]idempotentResult, 4)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], _, _ = store.do(context.Background(), "key", "a", run)
+		}(i)
+	}
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), calls)
+	for _, result := range results {
+		assert.Equal(t, int32(1), result.(storedCount).n)
+	}
+
+	_, _, err := store.do(context.Background(), "key", "b", run)
+	assert.Equal(t, idempotencyConflictError{}, err)
+
+	// Results that are not kept, and expired ones, run again.
+	_, _, _ = store.do(context.Background(), "other", "a", func() (idempotentResult, bool) { return nil, false })
+	_, replayed, _ := store.do(context.Background(), "other", "a", run)
+	assert.False(t, replayed)
+
+	now = now.Add(2 * time.Minute)
+	result, replayed, _ := store.do(context.Background(), "key", "b", run)
+	assert.False(t, replayed)
+	assert.Equal(t, int32(3), result.(storedCount).n)
+}
+
+func TestIdempotencyStoreLimits(t *testing.T) {
+	store := newIdempotencyStore(time.Minute, 3, 100)
+	keep := func(bytes int) func() (idempotentResult, bool) {
+		return func() (idempotentResult, bool) { return storedCount{0, bytes}, true }
+	}
+	replays := func(key string) bool {
+		_, replayed, _ := store.do(context.Background(), key, "f", keep(0))
+		return replayed
+	}
+
+	for _, key := range []string{"a", "b", "c"} {
+		_, _, _ = store.do(context.Background(), key, "f", keep(10))
+	}
+	// a is used again, so b is the least recently used entry when d arrives.
+	assert.True(t, replays("a"))
+	_, _, _ = store.do(context.Background(), "d", "f", keep(10))
+	assert.Equal(t, 3, store.order.Len())
+	assert.NotContains(t, store.entries, "b")
+
+	// A large result evicts entries until the byte budget is met.
+	_, _, _ = store.do(context.Background(), "e", "f", keep(80))
+	assert.LessOrEqual(t, store.bytes, 100)
+	assert.Contains(t, store.entries, "e")
+	assert.Equal(t, 2, store.order.Len())
+
+	// A result larger than the budget is returned but not stored.
+	result, _, _ := store.do(context.Background(), "f", "f", keep(200))
+	assert.Equal(t, 200, result.size())
+	assert.NotContains(t, store.entries, "f")
+	assert.Equal(t, 0, store.bytes)
+}
+
+func TestHTTPIdempotency(t *testing.T) {
+	counting := &uppercaseCountingService{StringService: makeSvc()}
+	srv := httptest.NewServer(makeHTTPHandler(counting, makeJobs(counting)))
+	defer srv.Close()
+
+	post := func(token string, key string, body string) (*http.Response, batchResponse) {
+		req, _ := http.NewRequest("POST", srv.URL+"/batch", strings.NewReader(body))
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.Header.Set(idempotencyKeyHeader, key)
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		var response batchResponse
+		_ = json.NewDecoder(resp.Body).Decode(&response)
+		return resp, response
+	}
+
+	token := testToken(t)
+	body := `{"items": [{"op": "uppercase", "s": "hello"}]}`
+	first, firstResults := post(token, "retry-1", body)
+	second, secondResults := post(token, "retry-1", body)
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&counting.calls))
+	assert.Equal(t, http.StatusOK, second.StatusCode)
+	assert.Equal(t, firstResults, secondResults)
+	assert.Empty(t, first.Header.Get(idempotentReplayHeader))
+	assert.Equal(t, "true", second.Header.Get(idempotentReplayHeader))
+
+	conflict, _ := post(token, "retry-1", `{"items": [{"op": "uppercase", "s": "bye"}]}`)
+	assert.Equal(t, http.StatusUnprocessableEntity, conflict.StatusCode)
+
+	// Keys belong to a user, another one may use the same key.
+	other, err := generateToken(authConfig.key, "user2")
+	assert.NoError(t, err)
+	_, otherResults := post(other, "retry-1", body)
+	assert.Equal(t, "HELLO", otherResults.Results[0].V)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&counting.calls))
+}
+
+func TestHTTPAuthIdempotency(t *testing.T) {
+	srv := httptest.NewServer(makeHTTPHandler(makeSvc(), makeJobs(makeSvc())))
+	defer srv.Close()
+
+	post := func(key string, body string) (*http.Response, authResponse) {
+		req, _ := http.NewRequest("POST", srv.URL+"/auth", strings.NewReader(body))
+		req.Header.Set(idempotencyKeyHeader, key)
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		var response authResponse
+		_ = json.NewDecoder(resp.Body).Decode(&response)
+		return resp, response
+	}
+	valid := `{"username": "user1", "password": "passwordOne"}`
+	wrong := `{"username": "user1", "password": "guess"}`
+
+	first, firstToken := post("retry-1", valid)
+	second, secondToken := post("retry-1", valid)
+	assert.Equal(t, http.StatusOK, first.StatusCode)
+	assert.Empty(t, first.Header.Get(idempotentReplayHeader))
+	assert.Equal(t, "true", second.Header.Get(idempotentReplayHeader))
+	assert.Equal(t, firstToken, secondToken)
+
+	conflict, _ := post("retry-1", wrong)
+	assert.Equal(t, http.StatusUnprocessableEntity, conflict.StatusCode)
+
+	// Failed attempts are not stored, so they cannot hold a key for the user.
+	failed, _ := post("retry-2", wrong)
+	assert.NotEqual(t, http.StatusOK, failed.StatusCode)
+	resp, response := post("retry-2", valid)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Empty(t, resp.Header.Get(idempotentReplayHeader))
+	assert.NotEmpty(t, response.Token)
+}
+
+func TestGRPCIdempotency(t *testing.T) {
+	counting := &uppercaseCountingService{StringService: makeSvc()}
+	conn, stop := dialTestGRPCServer(t, counting)
+	defer stop()
+	client := pb.NewStringServiceClient(conn)
+
+	md := metadata.Pairs("authorization", fmt.Sprintf("Bearer %s", testToken(t)), "idempotency-key", "retry-1")
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	request := &pb.BatchRequest{Items: []*pb.BatchItem{{Op: OpUppercase, S: "hello"}}}
+	first, err := client.Batch(ctx, request)
+	assert.NoError(t, err)
+
+	var header metadata.MD
+	second, err := client.Batch(ctx, request, grpc.Header(&header))
+	assert.NoError(t, err)
+	assert.Equal(t, first.Results[0].V, second.Results[0].V)
+	assert.Equal(t, []string{"true"}, header.Get("idempotent-replayed"))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&counting.calls))
+
+	_, err = client.Batch(ctx, &pb.BatchRequest{Items: []*pb.BatchItem{{Op: OpUppercase, S: "bye"}}})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestGRPCAuthIdempotency(t *testing.T) {
+	conn, stop := dialTestGRPCServer(t, makeSvc())
+	defer stop()
+	client := pb.NewStringServiceClient(conn)
+
+	withKey := func(key string) context.Context {
+		return metadata.NewOutgoingContext(context.Background(), metadata.Pairs("idempotency-key", key))
+	}
+	valid := &pb.AuthRequest{Username: "user1", Password: "passwordOne"}
+	wrong := &pb.AuthRequest{Username: "user1", Password: "guess"}
+
+	first, err := client.Auth(withKey("retry-1"), valid)
+	assert.NoError(t, err)
+	var header metadata.MD
+	second, err := client.Auth(withKey("retry-1"), valid, grpc.Header(&header))
+	assert.NoError(t, err)
+	assert.Equal(t, first.Token, second.Token)
+	assert.Equal(t, []string{"true"}, header.Get("idempotent-replayed"))
+
+	_, err = client.Auth(withKey("retry-1"), wrong)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// Failed attempts are not stored, so they cannot hold a key for the user.
+	_, err = client.Auth(withKey("retry-2"), wrong)
+	assert.Error(t, err)
+	header = nil
+	response, err := client.Auth(withKey("retry-2"), valid, grpc.Header(&header))
+	assert.NoError(t, err)
+	assert.NotEmpty(t, response.Token)
+	assert.Empty(t, header.Get("idempotent-replayed"))
+}
@@ -0,0 +1,332 @@
+package main
+
+import (
+	"container/list"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"sync"
+	"time"
+
+	gokitjwt "github.com/go-kit/kit/auth/jwt"
+)
+
+// Job states. Succeeded, failed and canceled jobs are finished and kept
+// until their retention expires or newer finished jobs push them out.
+const (
+	JobQueued    = "queued"
+	JobRunning   = "running"
+	JobSucceeded = "succeeded"
+	JobFailed    = "failed"
+	JobCanceled  = "canceled"
+)
+
+// Batch jobs report progress after every chunk of items.
+const jobChunkSize = 100
+
+// Finished jobs are dropped once their retention expires, checked every
+// jobExpiryInterval.
+const jobExpiryInterval = time.Minute
+
+var (
+	ErrJobNotFound  = errors.New("job not found")
+	ErrJobQueueFull = errors.New("too many jobs queued, retry later")
+)
+
+// JobSpec is the work of a job, either a batch or a pipeline. The finished
+// job is posted to CallbackURL when it is set.
+type JobSpec struct {
+	Batch       []batchItem
+	Pipeline    *pipelineRequest
+	CallbackURL string
+}
+
+// Job is a snapshot of a job. Results holds the batch items processed so
+// far and Pipeline the output of a succeeded pipeline job; Error explains
+// why a job failed or was canceled.
+type Job struct {
+	ID       string
+	Status   string
+	Done     int
+	Total    int
+	Results  []batchResult
+	Pipeline *PipelineResult
+	Error    string
+	Created  time.Time
+	Updated  time.Time
+}
+
+// JobService runs specs in the background. Jobs are only visible to the
+// user who submitted them.
+type JobService interface {
+	Submit(owner string, spec JobSpec) (Job, error)
+	Get(owner string, id string) (Job, error)
+	Cancel(owner string, id string) (Job, error)
+	Deliveries(owner string, id string) ([]DeliveryAttempt, error)
+}
+
+// jobManager is a JobService running jobs through a StringService on a
+// fixed number of workers, fed by a bounded queue. It keeps at most
+// maxFinished finished jobs holding maxBytes of inputs and results, dropping
+// the oldest first.
+type jobManager struct {
+	svc         StringService
+	webhooks    *webhookSender
+	queue       chan *job
+	ttl         time.Duration
+	maxFinished int
+	maxBytes    int
+	now         func() time.Time
+
+	mtx      sync.Mutex
+	jobs     map[string]*job
+	finished *list.List
+	bytes    int
+}
+
+type job struct {
+	Job
+	owner      string
+	spec       JobSpec
+	ctx        context.Context
+	cancel     context.CancelFunc
+	deliveries []DeliveryAttempt
+	size       int
+	el         *list.Element
+}
+
+func newJobManager(svc StringService, webhooks *webhookSender, workers int, queueSize int, ttl time.Duration, maxFinished int, maxBytes int) *jobManager {
+	m := &jobManager{
+		svc:         svc,
+		webhooks:    webhooks,
+		queue:       make(chan *job, queueSize),
+		ttl:         ttl,
+		maxFinished: maxFinished,
+		maxBytes:    maxBytes,
+		now:         time.Now,
+		jobs:        map[string]*job{},
+		finished:    list.New(),
+	}
+	for i := 0; i < workers; i++ {
+		go m.work()
+	}
+	go m.expireEvery(jobExpiryInterval)
+	return m
+}
+
+func newJobID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+func (m *jobManager) Submit(owner string, spec JobSpec) (Job, error) {
+	total := len(spec.Batch)
+	if spec.Pipeline != nil {
+		total = 1
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	now := m.now()
+	j := &job{
+		Job:    Job{ID: newJobID(), Status: JobQueued, Total: total, Created: now, Updated: now},
+		owner:  owner,
+		spec:   spec,
+		ctx:    ctx,
+		cancel: cancel,
+	}
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	select {
+	case m.queue <- j:
+	default:
+		cancel()
+		return Job{}, ErrJobQueueFull
+	}
+	m.jobs[j.ID] = j
+	return j.snapshot(), nil
+}
+
+func (m *jobManager) Get(owner string, id string) (Job, error) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	j, ok := m.jobs[id]
+	if !ok || j.owner != owner {
+		return Job{}, ErrJobNotFound
+	}
+	return j.snapshot(), nil
+}
+
+// Cancel stops a queued or running job; finished jobs are left as they are.
+// Running pipelines stop before their next step, and a pipeline whose last
+// step completes before then still succeeds.
+func (m *jobManager) Cancel(owner string, id string) (Job, error) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	j, ok := m.jobs[id]
+	if !ok || j.owner != owner {
+		return Job{}, ErrJobNotFound
+	}
+	switch j.Status {
+	case JobQueued:
+		m.finish(j, JobCanceled, context.Canceled.Error())
+	case JobRunning:
+		j.cancel()
+	}
+	return j.snapshot(), nil
+}
+
+// Deliveries returns the attempts made to post a job to its callback URL.
+func (m *jobManager) Deliveries(owner string, id string) ([]DeliveryAttempt, error) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	j, ok := m.jobs[id]
+	if !ok || j.owner != owner {
+		return nil, ErrJobNotFound
+	}
+	return append([]DeliveryAttempt{}, j.deliveries...), nil
+}
+
+// expireEvery expires jobs every interval.
+func (m *jobManager) expireEvery(interval time.Duration) {
+	for range time.Tick(interval) {
+		m.expire()
+	}
+}
+
+// expire drops finished jobs older than the retention.
+func (m *jobManager) expire() {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	now := m.now()
+	for el := m.finished.Front(); el != nil && now.Sub(el.Value.(*job).Updated) > m.ttl; el = m.finished.Front() {
+		m.remove(el.Value.(*job))
+	}
+}
+
+// remove drops the finished job j. The caller holds the lock.
+func (m *jobManager) remove(j *job) {
+	m.finished.Remove(j.el)
+	delete(m.jobs, j.ID)
+	m.bytes -= j.size
+}
+
+// finish records the outcome of j, drops the oldest finished jobs beyond
+// the limits and starts the callback delivery of j. The caller holds the lock.
+func (m *jobManager) finish(j *job, state string, reason string) {
+	j.Status, j.Error, j.Updated = state, reason, m.now()
+	j.cancel()
+
+	j.size = j.memory()
+	j.el = m.finished.PushBack(j)
+	m.bytes += j.size
+	for m.finished.Len() > m.maxFinished || (m.bytes > m.maxBytes && m.finished.Len() > 0) {
+		m.remove(m.finished.Front().Value.(*job))
+	}
+
+	if j.spec.CallbackURL != "" && m.webhooks != nil {
+		go m.webhooks.deliver(j.spec.CallbackURL, j.snapshot(), func(a DeliveryAttempt) {
+			m.mtx.Lock()
+			defer m.mtx.Unlock()
+			j.deliveries = append(j.deliveries, a)
+		})
+	}
+}
+
+// memory approximates the bytes held by the inputs and results of j.
+func (j *job) memory() int {
+	n := len(j.Error)
+	for _, item := range j.spec.Batch {
+		n += len(item.S)
+	}
+	for _, r := range j.Results {
+		n += len(r.V) + len(r.Err)
+	}
+	if j.spec.Pipeline != nil {
+		n += len(j.spec.Pipeline.S)
+	}
+	if j.Pipeline != nil {
+		n += len(j.Pipeline.Output)
+		for _, s := range j.Pipeline.Intermediate {
+			n += len(s)
+		}
+	}
+	return n
+}
+
+// snapshot copies the state of j for callers. The caller holds the lock.
+func (j *job) snapshot() Job {
+	s := j.Job
+	s.Results = append([]batchResult(nil), j.Results...)
+	return s
+}
+
+func (m *jobManager) work() {
+	for j := range m.queue {
+		m.mtx.Lock()
+		if j.Status != JobQueued {
+			m.mtx.Unlock()
+			continue
+		}
+		j.Status, j.Updated = JobRunning, m.now()
+		m.mtx.Unlock()
+
+		if j.spec.Pipeline != nil {
+			m.runPipeline(j)
+		} else {
+			m.runBatch(j)
+		}
+	}
+}
+
+func (m *jobManager) runBatch(j *job) {
+	for start := 0; start < len(j.spec.Batch); start += jobChunkSize {
+		if j.ctx.Err() != nil {
+			break
+		}
+		end := min(start+jobChunkSize, len(j.spec.Batch))
+		results := runBatch(j.ctx, m.svc, j.spec.Batch[start:end], batchWorkers)
+
+		m.mtx.Lock()
+		j.Results = append(j.Results, results...)
+		j.Done, j.Updated = end, m.now()
+		m.mtx.Unlock()
+	}
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	if err := j.ctx.Err(); err != nil {
+		m.finish(j, JobCanceled, err.Error())
+		return
+	}
+	m.finish(j, JobSucceeded, "")
+}
+
+func (m *jobManager) runPipeline(j *job) {
+	req := j.spec.Pipeline
+	result, err := m.svc.Pipeline(j.ctx, req.S, req.Steps, req.Intermediate)
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	switch {
+	case err == nil:
+		j.Done, j.Pipeline = 1, &result
+		m.finish(j, JobSucceeded, "")
+	case j.ctx.Err() != nil:
+		m.finish(j, JobCanceled, j.ctx.Err().Error())
+	default:
+		m.finish(j, JobFailed, err.Error())
+	}
+}
+
+// principalFromContext returns the user authenticated by the JWT parser.
+func principalFromContext(ctx context.Context) string {
+	if claims, ok := ctx.Value(gokitjwt.JWTClaimsContextKey).(*customClaims); ok {
+		return claims.Username
+	}
+	return ""
+}
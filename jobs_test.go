@@ -0,0 +1,214 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/fnaumov/gokit-stringsvc/pb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+)
+
+// waitJob polls a job until it is finished.
+func waitJob(t *testing.T, get func() (Job, error)) Job {
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		job, err := get()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if job.Status != JobQueued && job.Status != JobRunning {
+			return job
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("job %s still %s", job.ID, job.Status)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestJobManager(t *testing.T) {
+	jobs := newJobManager(makeSvc(), nil, 2, 10, time.Minute, jobRetained, jobRetainedBytes)
+
+	items := make([]batchItem, 250)
+	for i := range items {
+		items[i] = batchItem{Op: OpUppercase, S: fmt.Sprint("item ", i)}
+	}
+	submitted, err := jobs.Submit("user1", JobSpec{Batch: items})
+	assert.NoError(t, err)
+	assert.Equal(t, 250, submitted.Total)
+
+	job := waitJob(t, func() (Job, error) { return jobs.Get("user1", submitted.ID) })
+	assert.Equal(t, JobSucceeded, job.Status)
+	assert.Equal(t, 250, job.Done)
+	assert.Equal(t, "ITEM 249", job.Results[249].V)
+
+	_, err = jobs.Get("user2", submitted.ID)
+	assert.Equal(t, ErrJobNotFound, err)
+
+	submitted, _ = jobs.Submit("user1", JobSpec{Pipeline: &pipelineRequest{S: " a ", Steps: []PipelineStep{{Op: "trim"}, {Op: "nope"}}}})
+	job = waitJob(t, func() (Job, error) { return jobs.Get("user1", submitted.ID) })
+	assert.Equal(t, JobFailed, job.Status)
+	assert.Contains(t, job.Error, "step 1 (nope)")
+}
+
+func TestJobManagerQueue(t *testing.T) {
+	// Without workers jobs stay queued.
+	jobs := newJobManager(makeSvc(), nil, 0, 1, time.Minute, jobRetained, jobRetainedBytes)
+	now := time.Unix(0, 0)
+	jobs.now = func() time.Time { return now }
+
+	queued, err := jobs.Submit("user1", JobSpec{Batch: []batchItem{{OpCount, "abc"}}})
+	assert.NoError(t, err)
+	_, err = jobs.Submit("user1", JobSpec{Batch: []batchItem{{OpCount, "abc"}}})
+	assert.Equal(t, ErrJobQueueFull, err)
+
+	_, err = jobs.Cancel("user2", queued.ID)
+	assert.Equal(t, ErrJobNotFound, err)
+	canceled, err := jobs.Cancel("user1", queued.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, JobCanceled, canceled.Status)
+
+	jobs.expire()
+	_, err = jobs.Get("user1", queued.ID)
+	assert.NoError(t, err, "kept until the retention expires")
+	now = now.Add(2 * time.Minute)
+	jobs.expire()
+	_, err = jobs.Get("user1", queued.ID)
+	assert.Equal(t, ErrJobNotFound, err)
+}
+
+func TestJobManagerLimits(t *testing.T) {
+	jobs := newJobManager(makeSvc(), nil, 1, 10, time.Minute, 2, 20)
+	run := func(s string) string {
+		submitted, err := jobs.Submit("user1", JobSpec{Batch: []batchItem{{OpUppercase, s}}})
+		assert.NoError(t, err)
+		waitJob(t, func() (Job, error) { return jobs.Get("user1", submitted.ID) })
+		return submitted.ID
+	}
+	kept := func(id string) bool {
+		_, err := jobs.Get("user1", id)
+		return err == nil
+	}
+
+	// Each job holds its input and result, 2 bytes here.
+	a, b, c := run("a"), run("b"), run("c")
+	assert.False(t, kept(a), "the oldest finished job is dropped beyond the count")
+	assert.True(t, kept(b))
+	assert.True(t, kept(c))
+
+	// A job of 16 bytes leaves room for no more than one other.
+	d := run("abcdefgh")
+	assert.False(t, kept(b), "older jobs are dropped beyond the bytes")
+	assert.True(t, kept(c))
+	assert.True(t, kept(d))
+	assert.Equal(t, 18, jobs.bytes)
+}
+
+// blockingService runs pipelines on itself, holding Uppercase calls until
+// released and counting Lowercase calls.
+type blockingService struct {
+	StringService
+	started chan struct{}
+	release chan struct{}
+	lowered int32
+}
+
+func (s *blockingService) Pipeline(ctx context.Context, str string, steps []PipelineStep, intermediate bool) (PipelineResult, error) {
+	return runPipeline(ctx, s, str, steps, intermediate)
+}
+
+func (s *blockingService) Uppercase(str string, locale string) (string, error) {
+	s.started <- struct{}{}
+	<-s.release
+	return s.StringService.Uppercase(str, locale)
+}
+
+func (s *blockingService) Lowercase(str string, locale string) (string, error) {
+	atomic.AddInt32(&s.lowered, 1)
+	return s.StringService.Lowercase(str, locale)
+}
+
+func TestJobManagerCancelRunningPipeline(t *testing.T) {
+	svc := &blockingService{StringService: makeSvc(), started: make(chan struct{}), release: make(chan struct{})}
+	jobs := newJobManager(svc, nil, 1, 10, time.Minute, jobRetained, jobRetainedBytes)
+
+	submitted, err := jobs.Submit("user1", JobSpec{Pipeline: &pipelineRequest{S: "abc", Steps: []PipelineStep{{Op: OpUppercase}, {Op: OpLowercase}}}})
+	assert.NoError(t, err)
+	<-svc.started
+	running, err := jobs.Cancel("user1", submitted.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, JobRunning, running.Status)
+	close(svc.release)
+
+	job := waitJob(t, func() (Job, error) { return jobs.Get("user1", submitted.ID) })
+	assert.Equal(t, JobCanceled, job.Status)
+	assert.Equal(t, context.Canceled.Error(), job.Error)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&svc.lowered), "steps after the cancellation do not run")
+}
+
+func TestHTTPJobs(t *testing.T) {
+	svc := makeSvc()
+	srv := httptest.NewServer(makeHTTPHandler(svc, makeJobs(svc)))
+	defer srv.Close()
+
+	do := func(method string, path string, body string) jobResponse {
+		req, _ := http.NewRequest(method, srv.URL+path, strings.NewReader(body))
+		req.Header.Set("Authorization", "Bearer "+testToken(t))
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		var response jobResponse
+		_ = json.NewDecoder(resp.Body).Decode(&response)
+		return response
+	}
+
+	submitted := do("POST", "/jobs", `{"pipeline": {"s": "  hello ", "steps": [{"op": "trim"}, {"op": "uppercase"}]}}`)
+	assert.Empty(t, submitted.Err)
+	assert.NotEmpty(t, submitted.ID)
+
+	job := waitJob(t, func() (Job, error) {
+		r := do("GET", "/jobs/"+submitted.ID, "")
+		return Job{ID: r.ID, Status: r.Status}, nil
+	})
+	assert.Equal(t, JobSucceeded, job.Status)
+	assert.Equal(t, "HELLO", do("GET", "/jobs/"+submitted.ID, "").Pipeline.V)
+
+	assert.Equal(t, ErrJobNotFound.Error(), do("DELETE", "/jobs/unknown", "").Err)
+	assert.Error(t, submitJobRequest{}.validate())
+}
+
+func TestGRPCJobs(t *testing.T) {
+	conn, stop := dialTestGRPCServer(t, makeSvc())
+	defer stop()
+	client := pb.NewStringServiceClient(conn)
+
+	md := metadata.Pairs("authorization", fmt.Sprintf("Bearer %s", testToken(t)))
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	submitted, err := client.SubmitJob(ctx, &pb.SubmitJobRequest{
+		Batch: &pb.BatchRequest{Items: []*pb.BatchItem{{Op: OpCount, S: "abc"}}},
+	})
+	assert.NoError(t, err)
+
+	job := waitJob(t, func() (Job, error) {
+		r, err := client.GetJob(ctx, &pb.JobRequest{Id: submitted.Id})
+		if err != nil {
+			return Job{}, err
+		}
+		return Job{ID: r.Id, Status: r.Status}, nil
+	})
+	assert.Equal(t, JobSucceeded, job.Status)
+
+	response, err := client.GetJob(ctx, &pb.JobRequest{Id: submitted.Id})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), response.Results[0].N)
+}
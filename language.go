@@ -0,0 +1,181 @@
+package main
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Detection parameters: the number of trigrams compared, how sharply score
+// differences separate confidences, and how many languages are reported.
+const (
+	profileSize      = 300
+	confidenceScale  = 25
+	maxLanguageGuess = 3
+)
+
+// LanguageScore is a candidate language, as a BCP-47 tag, with its confidence in [0, 1].
+type LanguageScore struct {
+	Language   string  `json:"language"`
+	Confidence float64 `json:"confidence"`
+}
+
+// LanguageResult holds the dominant script of a string and its most likely
+// languages, best first; Languages is empty when the script gives no clue.
+type LanguageResult struct {
+	Script    string          `json:"script"`
+	Languages []LanguageScore `json:"languages"`
+}
+
+// scriptLanguages are scripts that on their own identify a language.
+var scriptLanguages = map[string]string{
+	"Arabic":     "ar",
+	"Armenian":   "hy",
+	"Devanagari": "hi",
+	"Georgian":   "ka",
+	"Greek":      "el",
+	"Han":        "zh",
+	"Hangul":     "ko",
+	"Hebrew":     "he",
+	"Hiragana":   "ja",
+	"Katakana":   "ja",
+	"Thai":       "th",
+}
+
+// profileRanks maps each language to the rank of its trigrams and
+// scriptProfiles lists the languages profiled for each script.
+var (
+	profileRanks   = map[string]map[string]int{}
+	scriptProfiles = map[string][]string{}
+)
+
+func init() {
+	for lang, trigrams := range languageProfiles {
+		ranks := make(map[string]int, len(trigrams))
+		for i, t := range trigrams {
+			ranks[t] = i
+		}
+		profileRanks[lang] = ranks
+
+		for _, r := range strings.Join(trigrams, "") {
+			if script := scriptOf(r); script != "" {
+				scriptProfiles[script] = append(scriptProfiles[script], lang)
+				break
+			}
+		}
+	}
+	for _, langs := range scriptProfiles {
+		sort.Strings(langs)
+	}
+}
+
+// languageTrigrams returns the trigrams of the lowercased words of s, each
+// padded with spaces, most frequent first.
+func languageTrigrams(s string) []string {
+	counts := map[string]int{}
+	words := strings.FieldsFunc(strings.ToLower(s), func(r rune) bool { return !unicode.IsLetter(r) })
+	for _, w := range words {
+		padded := []rune(" " + w + " ")
+		for i := 0; i+3 <= len(padded); i++ {
+			counts[string(padded[i:i+3])]++
+		}
+	}
+
+	trigrams := make([]string, 0, len(counts))
+	for t := range counts {
+		trigrams = append(trigrams, t)
+	}
+	sort.Slice(trigrams, func(i, j int) bool {
+		a, b := trigrams[i], trigrams[j]
+		if counts[a] != counts[b] {
+			return counts[a] > counts[b]
+		}
+		return a < b
+	})
+	if len(trigrams) > profileSize {
+		trigrams = trigrams[:profileSize]
+	}
+	return trigrams
+}
+
+// scriptCounts counts the letters of s in each script.
+func scriptCounts(s string) map[string]int {
+	counts := map[string]int{}
+	for _, r := range s {
+		if script := scriptOf(r); script != "" {
+			counts[script]++
+		}
+	}
+	return counts
+}
+
+// dominantScript returns the script with the most letters, ties going to
+// the first name.
+func dominantScript(counts map[string]int) string {
+	dominant := ""
+	for script, n := range counts {
+		if n > counts[dominant] || n == counts[dominant] && script < dominant {
+			dominant = script
+		}
+	}
+	return dominant
+}
+
+// rankLanguages scores langs by the out-of-place distance between their
+// profiles and the trigrams of s, and turns the scores into confidences.
+func rankLanguages(s string, langs []string) []LanguageScore {
+	trigrams := languageTrigrams(s)
+	if len(trigrams) == 0 {
+		return []LanguageScore{}
+	}
+
+	scores := make([]LanguageScore, len(langs))
+	var total float64
+	for i, lang := range langs {
+		distance := 0
+		for rank, t := range trigrams {
+			d := profileSize
+			if p, ok := profileRanks[lang][t]; ok && abs(p-rank) < profileSize {
+				d = abs(p - rank)
+			}
+			distance += d
+		}
+		similarity := 1 - float64(distance)/float64(len(trigrams)*profileSize)
+		weight := math.Exp(confidenceScale * similarity)
+		scores[i] = LanguageScore{lang, weight}
+		total += weight
+	}
+	for i := range scores {
+		scores[i].Confidence /= total
+	}
+
+	sort.SliceStable(scores, func(i, j int) bool { return scores[i].Confidence > scores[j].Confidence })
+	if len(scores) > maxLanguageGuess {
+		scores = scores[:maxLanguageGuess]
+	}
+	return scores
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+func detectLanguage(s string) LanguageResult {
+	counts := scriptCounts(s)
+	result := LanguageResult{Script: dominantScript(counts), Languages: []LanguageScore{}}
+	lang, ok := scriptLanguages[result.Script]
+	if result.Script == "Han" && counts["Hiragana"]+counts["Katakana"] > 0 {
+		// Japanese mixes kanji with kana, Chinese has none.
+		lang = "ja"
+	}
+	if ok {
+		result.Languages = append(result.Languages, LanguageScore{lang, 1})
+	} else if langs, ok := scriptProfiles[result.Script]; ok {
+		result.Languages = rankLanguages(s, langs)
+	}
+	return result
+}
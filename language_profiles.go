@@ -0,0 +1,240 @@
+package main
+
+// languageProfiles are the most frequent trigrams of each language, most
+// frequent first, counted over sample text by languageTrigrams.
+var languageProfiles = map[string][]string{
+	"de": {
+		"en ", "er ", "der", "nd ", " un", "sch", "ten", "und", " de", " di", " ge", "cht", "ie ",
+		" da", " re", " si", "die", " be", " ha", " so", "ch ", "che", "ich", "nen", " od", " wi",
+		"ach", "das", "ech", "ei ", "ein", "eit", "gen", "ht ", "in ", "it ", "nde", "ode", "rei",
+		"ver", " au", " fr", " in", " na", " sp", " ve", " we", "abe", "and", "ass", "auf", "bei",
+		"ben", "ber", "ede", "em ", "ens", "erk", "fre", "ft ", "hei", "her", "hte", "ige", "ind",
+		"le ", "len", "lle", "lte", "mit", "nsc", "on ", "rec", "sie", "son", "spr", "sse", "st ",
+		"te ", "uf ", "wir", " al", " an", " br", " ei", " es", " gl", " he", " im", " je", " le",
+		" mi", " ni", " sc", " st", " wa", " üb", "ale", "alt", "art", "as ", "at ", "be ", "beg",
+		"bt ", "chi", "chs", "den", "ebe", "ege", "egn", "eih", "ele", "ema", "end", "ers", "es ",
+		"ese", "geb", "gem", "gne", "hal", "hat", "hau", "he ", "hne", "hre", "ied", "iel", "ies",
+		"ihe", "im ", "ine", "ing", "ion", "ir ", "ist", "jed", "lei", "ler", "men", "nac", "ne ",
+		"nft", "ng ", "nn ", "ns ", "nst", "och", "oll", "ons", "rde", "reg", "ren", "rt ", "rte",
+		"ser", "sin", "sol", "ss ", "sta", "sti", "ter", "tig", "uch", "unf", "ung", "uns", "ähr",
+		"übe", " ab", " du", " er", " et", " fa", " fu", " fä", " fü", " ga", " gi", " hi", " ho",
+		" hu", " ic", " ih", " ir", " is", " ki", " kö", " me", " mö", " ne", " no", " nä", " oh",
+		" pe", " po", " pr", " ra", " se", " sk", " vi", " wo", " wä", " wü", " zu", "abt", "adt",
+		"aft", "ag ", "alb", "all", "an ", "ank", "ann", "ans", "arb", "arf", "ati", "aub", "aul",
+		"aun", "aus", "aut", "ave", "bed", "bes", "bor", "bra", "brü", "bur", "cha", "chk", "chl",
+		"chm", "chn", "cho", "dab", "dan", "dar", "de ", "dei", "dem", "des", "det", "din", "dt ",
+		"du ", "ebo", "ebu", "ed ", "eda", "ega", "eha", "ehm", "eib", "eic", "eig", "eis", "ekt",
+		"eli", "ell", "enn", "erd", "ere", "erh", "erl", "erm", "ern", "erz", "esc", "esh", "ess",
+		"est", "ete", "ett", "etw", "eug", "eut", "ewi", "far", "fau", "fe ", "fen", "ffe", "fuc",
+		"fäh", "für", "gab", "gar", "ge ", "geg", "geh", "gei", "ger", "ges", "gew", "gib", "gio",
+		"gla",
+	},
+	"en": {
+		" th", "the", " an", "he ", "in ", "nd ", "and", "on ", "or ", " be", " to", " we", "er ",
+		"her", "ld ", " fo", " in", " or", " wi", " yo", "hou", "ing", "ion", "is ", "ne ", "one",
+		"re ", "th ", "thi", "to ", "ty ", "you", " se", " sh", " wh", "al ", "ave", "en ", "for",
+		"ith", "ll ", "oth", "ou ", "oul", "rig", "uld", "ver", "wit", " ar", " do", " ha", " li",
+		" of", " ri", "ain", "all", "are", "at ", "be ", "ery", "ght", "hat", "his", "igh", "ity",
+		"ng ", "of ", "sho", "tha", "tio", "ve ", "we ", " al", " br", " co", " di", " en", " ev",
+		" fr", " he", " ho", " is", " la", " ne", " no", " on", " ot", " pr", " ra", " re", " so",
+		"an ", "any", "ard", "as ", "ati", "bro", "ce ", "ch ", "ct ", "ed ", "ere", "ert", "et ",
+		"eve", "ey ", "fre", "gs ", "hav", "hel", "hey", "hil", "hin", "hts", "igi", "it ", "iti",
+		"ke ", "le ", "man", "ngs", "nk ", "not", "ny ", "oin", "ope", "our", "per", "pro", "rai",
+		"ree", "rel", "rit", "rn ", "rth", "rty", "ryo", "son", "ter", "tin", "ts ", "ur ", "us ",
+		"ut ", "whi", "yon", " a ", " ab", " ac", " af", " ag", " as", " bi", " bo", " bu", " ch",
+		" ci", " de", " eq", " ga", " go", " hu", " i ", " it", " jo", " ju", " ki", " le", " ma",
+		" na", " ol", " op", " ov", " pe", " pl", " po", " qu", " sl", " sp", " st", " su", " ta",
+		" ti", " tr", " tu", " um", " us", " wa", " wo", " ye", "abl", "ace", "act", "aft", "aga",
+		"age", "ait", "ake", "ang", "ank", "ano", "ar ", "ara", "aso", "ath", "atu", "ayi", "azy",
+		"bee", "bei", "ber", "bet", "bir", "ble", "bor", "bre", "but", "cal", "chi", "cia", "cie",
+		"cit", "ck ", "cla", "col", "con", "cti", "cur", "de ", "dec", "den", "dig", "dis", "doe",
+		"dog", "dom", "don", "dow", "dre", "ds ", "ear", "eas", "eat", "eav", "ecl", "ect", "ecu",
+		"edo", "ee ", "eed", "eek", "een", "ein", "eir", "ek ", "eld", "eli", "ell", "elp", "enc",
+		"end", "ent", "equ", "erh", "ern", "ers", "erv", "es ", "ett", "ex ", "ext", "fe ", "fox",
+		"fte", "gai", "gar", "ge ", "gin", "gio", "gni", "goi", "gua", "hal", "han", "has", "hei",
+		"hen", "hic", "hoo", "hop", "ht ", "hum", "ial", "ibe", "ica", "ich", "ick", "ien", "ife",
+		"ign",
+	},
+	"es": {
+		"os ", " de", " co", " es", "ón ", " a ", "en ", "per", " la", " qu", " se", " y ", "con",
+		"de ", "ere", "est", "ión", "la ", " el", " lo", " pe", " to", "as ", "do ", "el ", "ien",
+		"los", "que", "ra ", "tod", " na", " ot", "ad ", "cho", "ció", "da ", "dos", "ech", "es ",
+		"na ", "nos", "otr", "tra", "ue ", " cu", " en", " li", " o ", " pr", " so", " ti", "aci",
+		"ara", "ber", "bre", "ca ", "cla", "com", "cua", "dad", "der", "ent", "er ", "ida", "le ",
+		"lib", "mos", "nac", "nci", "ndo", "odo", "ona", "rec", "ro ", "rta", "sta", "ta ", "tad",
+		"tar", "tie", "ual", "vid", " di", " he", " ll", " ni", " no", " pa", " po", " ra", " sa",
+		" su", " un", " vi", "ade", "ado", "al ", "ale", "alq", "and", "ard", "cer", "cia", "deb",
+		"dec", "ebe", "emo", "ene", "era", "ers", "ert", "esp", "gua", "ho ", "hos", "ibe", "ica",
+		"ici", "ido", "idu", "ier", "lqu", "ma ", "man", "mie", "mpo", "nal", "ndi", "ne ", "oda",
+		"omp", "on ", "or ", "par", "pro", "qui", "raz", "re ", "res", "rro", "rso", "sal", "ser",
+		"son", "spe", "stá", "su ", "te ", "to ", "tán", "uda", "uie", "án ", " ac", " ag", " al",
+		" as", " ay", " ca", " ce", " ci", " cr", " do", " e ", " ec", " fr", " ha", " ho", " hu",
+		" id", " ig", " in", " ja", " ju", " ma", " me", " mi", " mu", " op", " or", " pu", " re",
+		" rá", " si", " ta", " tr", " va", " ve", " zo", " ín", "ace", "aco", "adi", "agr", "agu",
+		"alg", "alm", "alt", "ama", "amo", "an ", "ana", "ano", "ar ", "arn", "arr", "ars", "ará",
+		"asa", "así", "ate", "avi", "aví", "ay ", "ayu", "aza", "azó", "aña", "ben", "cas", "cen",
+		"cha", "cie", "cim", "cio", "ciu", "col", "cos", "cre", "cto", "dav", "des", "dic", "die",
+		"dig", "dio", "dis", "div", "dol", "dot", "dum", "duo", "dín", "ea ", "ece", "ecl", "eco",
+		"ect", "eda", "ega", "egu", "eja", "ejo", "eli", "ema", "emp", "enc", "eo ", "erc", "erl",
+		"ern", "ero", "err", "erv", "erí", "esc", "eti", "eva", "exo", "ez ", "ezo", "fra", "gan",
+		"gen", "gió", "gni", "go ", "gra", "gun", "gur", "has", "hay", "hec", "hem", "hor", "hum",
+		"ia ", "ial", "ibr", "idi", "ie ", "iej", "iem", "ige", "igi", "ign", "igo", "igu", "ima",
+		"imi",
+	},
+	"fr": {
+		"de ", " de", "es ", "us ", " le", "et ", "le ", "ns ", "ous", " la", "ion", "on ", " en",
+		" et", " pr", " to", "la ", "les", "ne ", "nt ", "re ", " no", " qu", "ais", "ent", "tou",
+		" pa", " se", "en ", "nou", "que", "tre", "ue ", " au", " il", " pe", " po", " re", " vo",
+		" à ", "ain", "ans", "aut", "ce ", "dan", "lle", "mai", "ons", "out", "par", "res", "son",
+		"te ", "tio", "té ", "un ", "ute", " av", " ch", " da", " dr", " es", " li", " na", " ou",
+		" ra", " sa", " un", " vi", "ati", "cla", "dro", "end", "il ", "ine", "ir ", "lib", "oit",
+		"ou ", "our", "pou", "pro", "rai", "roi", "ts ", "ur ", "utr", "vou", "és ", " a ", " be",
+		" ce", " co", " d ", " di", " do", " ma", " op", " pl", " so", "ale", "alo", "ant", "ara",
+		"ard", "auc", "ave", "bea", "ber", "cha", "cie", "cou", "cun", "dre", "eau", "ec ", "ell",
+		"enc", "era", "ers", "ert", "esp", "ess", "eur", "ibe", "ide", "ie ", "ien", "igi", "ill",
+		"in ", "ind", "ini", "is ", "iso", "iss", "it ", "its", "itu", "ité", "leu", "nai", "nce",
+		"ndr", "nio", "nit", "oir", "opi", "pen", "pin", "plu", "prè", "pré", "ra ", "rap", "rat",
+		"ren", "roc", "rs ", "rté", "rès", "san", "se ", "sen", "ser", "sse", "ten", "tes", "une",
+		"ut ", "ux ", "vec", "vie", "ès ", " ag", " ai", " al", " ap", " at", " br", " dé", " el",
+		" fa", " fe", " fo", " fr", " he", " hu", " in", " ja", " je", " jo", " mi", " n ", " ne",
+		" ni", " nu", " or", " si", " sû", " te", " tr", " tu", " va", " y ", " ég", " êt", "ace",
+		"acu", "age", "agi", "aid", "ait", "amm", "amé", "anc", "and", "ang", "api", "apl", "apr",
+		"ar ", "are", "art", "as ", "ate", "att", "au ", "aux", "ava", "avo", "bre", "bru", "cet",
+		"chi", "cho", "cia", "con", "cor", "cti", "den", "des", "dev", "di ", "dig", "din", "dis",
+		"div", "doi", "dou", "dri", "du ", "déc", "eil", "ejo", "eli", "ema", "eme", "ena", "enf",
+		"ens", "enu", "env", "er ", "erc", "ern", "erv", "esc", "eté", "eut", "euv", "eux", "evr",
+		"exe", "ez ", "fai", "fan", "fer", "for", "fra", "gau", "ge ", "gin", "gio", "gir", "gni",
+		"gue", "hac", "hai", "heu", "hie", "hos", "hum", "ial", "ibr", "idi", "idu", "iei", "ier",
+		"ign",
+	},
+	"it": {
+		" di", "di ", "no ", "la ", "ne ", " al", "ion", "to ", "lla", "one", "re ", "te ", " co",
+		" in", "ni ", "ti ", "za ", " e ", " pe", " pr", "che", "ess", "gio", "ndi", "per", "tti",
+		" ch", " il", " li", " o ", " po", " se", " so", "all", "alt", "con", "ell", "eri", "ett",
+		"gli", "he ", "il ", "ind", "iri", "itt", "li ", "ono", "ra ", "rit", "tà ", "zio", " ci",
+		" es", " gl", " la", " na", " ne", " og", " qu", " ra", " sp", " te", " tu", " un", " ve",
+		" vo", "agi", "ate", "azi", "ber", "chi", "dir", "div", "duo", "er ", "ere", "gni", "hia",
+		"iar", "ibe", "idu", "in ", "ivi", "le ", "lib", "ltr", "mo ", "na ", "nza", "on ", "pro",
+		"raz", "ri ", "ro ", "rvi", "rà ", "ser", "so ", "son", "spe", "ssi", "ta ", "tan", "tto",
+		"tut", "uo ", "utt", "vid", "vit", "zza", " a ", " ca", " de", " do", " ed", " gi", " i ",
+		" ma", " no", " or", " pi", " ri", " sa", " st", " vi", "ale", "amo", "and", "ano", "asc",
+		"can", "cch", "ci ", "cia", "cit", "cos", "do ", "ed ", "ent", "enu", "enz", "ers", "ert",
+		"est", "ezz", "ggi", "gua", "ia ", "iam", "iat", "igi", "ima", "ing", "ini", "ino", "ita",
+		"itù", "ma ", "man", "nas", "ndo", "nel", "ogn", "ona", "opr", "ora", "ore", "oss", "pet",
+		"pre", "rag", "rel", "ren", "res", "ria", "rig", "rso", "rtà", "sci", "sen", "sse", "sta",
+		"sti", "tat", "tre", "tro", "tta", "tù ", "un ", "uni", "uto", "ver", "vi ", " ab", " ad",
+		" ag", " ai", " an", " as", " ba", " eg", " en", " fa", " fr", " ge", " ha", " le", " me",
+		" mi", " mo", " nu", " om", " op", " pa", " re", " sc", " si", " tr", " um", "abb", "ad ",
+		"aiu", "alc", "ali", "amb", "ana", "anc", "ane", "ani", "ann", "anz", "ara", "ard", "arr",
+		"art", "arv", "arà", "asa", "asp", "ati", "ato", "att", "avi", "azz", "bam", "bbi", "bia",
+		"bin", "bre", "ca ", "cas", "ce ", "cie", "cin", "col", "cor", "cun", "cur", "del", "der",
+		"dev", "dic", "dig", "din", "dis", "diz", "dot", "dov", "ecc", "egu", "el ", "eli", "elo",
+		"emm", "emp", "end", "ene", "eno", "ens", "erv", "erà", "ese", "evo", "fat", "fra", "gen",
+		"get", "gi ", "gia", "gin", "gir", "gra", "gro", "ha ", "hez", "ial", "iav", "ica", "icc",
+		"ich",
+	},
+	"nl": {
+		"en ", "er ", " ge", "der", " de", " en", "de ", " he", "et ", "in ", "nde", " we", "aar",
+		"gen", "ij ", "ver", " in", " zi", "and", "cht", "den", "een", "eid", "hei", "id ", " be",
+		" di", " ee", " me", " of", " op", " re", " va", " ve", "an ", "at ", "ede", "ens", "ers",
+		"het", "ing", "le ", "lle", "of ", "sch", "ten", "van", "zij", " ho", " on", " vo", " vr",
+		" wa", "aat", "die", "ech", "eer", "end", "ere", "hte", "ijn", "jn ", "ke ", "nd ", "ond",
+		"op ", "rec", "ren", "rij", "st ", "sta", "vri", "wee", " aa", " al", " an", " bi", " br",
+		" da", " hu", " ie", " je", " ma", " na", " ni", " ov", " sp", " st", " te", " u ", " wi",
+		" wo", " zo", "aan", "ach", "al ", "all", "ar ", "ard", "arh", "baa", "bij", "cha", "che",
+		"dat", "ebo", "eda", "eef", "eft", "ege", "eho", "eli", "elk", "ene", "ert", "ete", "ft ",
+		"geb", "ged", "ges", "hap", "hee", "ht ", "ie ", "ied", "ien", "ijh", "ijk", "is ", "jhe",
+		"len", "lij", "maa", "mee", "men", "ng ", "nie", "ns ", "nst", "ons", "oor", "ord", "ore",
+		"oud", "ove", "pel", "rde", "re ", "rhe", "rin", "rsc", "sla", "spr", "te ", "ter", "tre",
+		"tui", "ude", "uin", "us ", "we ", "wij", "wor", "ze ", " af", " do", " du", " ei", " el",
+		" er", " ga", " go", " ik", " is", " ki", " kl", " ku", " la", " le", " lu", " mo", " no",
+		" oo", " ou", " pa", " pe", " po", " pr", " ra", " sl", " sn", " ta", " tr", " tu", " uw",
+		" za", " ze", "aak", "aal", "ad ", "afk", "ag ", "age", "ak ", "ale", "als", "ank", "anm",
+		"ann", "ans", "ap ", "apl", "app", "ara", "ari", "as ", "ati", "ats", "atu", "ave", "bbe",
+		"bed", "beg", "beh", "ben", "bet", "boo", "bor", "bro", "bru", "ch ", "ct ", "daa", "dag",
+		"dan", "dba", "dda", "dez", "dig", "din", "dit", "doe", "dom", "dra", "dsd", "dus", "ebb",
+		"ect", "edr", "ee ", "eek", "eel", "ees", "egi", "eig", "ein", "ek ", "eke", "ekt", "el ",
+		"ele", "ell", "ema", "eme", "eni", "enk", "erk", "ern", "erw", "esl", "eso", "est", "eur",
+		"eve", "ewe", "eze", "fko", "fti", "gaa", "gd ", "gee", "geh", "gel", "gew", "ghe", "gif",
+		"gin", "god", "gt ", "heb", "hed", "hen", "hoe", "hon", "hop", "hor", "hou", "hui", "hul",
+		"ich",
+	},
+	"pt": {
+		"de ", "os ", " de", "ão ", " es", "as ", "em ", " co", " se", " qu", "que", " e ", "to ",
+		" ou", "ade", "do ", "er ", "est", "ue ", " a ", "com", "da ", "dad", "ida", "ito", "ra ",
+		" di", " em", " na", " po", " pr", "ado", "es ", "esp", "man", "ou ", "res", "sa ", " as",
+		" el", " no", " o ", " os", " pe", " vo", " à ", "dos", "ent", "men", "mos", "na ", "om ",
+		"per", "ser", "te ", "ua ", "voc", " ca", " li", " ma", " me", " mu", " nã", " ra", " su",
+		" to", "al ", "amo", "ar ", "ara", "ard", "cas", "con", "cê ", "dir", "eit", "ela", "ess",
+		"gua", "ire", "mui", "nos", "nte", "nto", "não", "ocê", "odo", "or ", "out", "qua", "rda",
+		"rei", "spe", "ssa", "sso", "sta", "sua", "ta ", "tar", "tod", "tos", "uit", "uma", "utr",
+		"ção", " ag", " ch", " ci", " ho", " hu", " in", " ju", " nó", " pa", " re", " sa", " si",
+		" so", " ta", " te", " va", "ai ", "ama", "ame", "anh", "ano", "ant", "anç", "asc", "açã",
+		"ber", "bri", "cho", "cia", "cid", "cla", "dec", "der", "dev", "ece", "egu", "ele", "elh",
+		"era", "erd", "ere", "gem", "ha ", "hor", "hum", "ia ", "ibe", "ica", "im ", "ind", "ir ",
+		"ita", "ião", "jun", "la ", "lib", "ma ", "mas", "nas", "ngu", "nid", "nsa", "nti", "nça",
+		"nós", "obr", "oje", "oss", "par", "pes", "por", "pos", "pre", "pro", "raç", "rig", "sci",
+		"se ", "sem", "sim", "so ", "soa", "ste", "sti", "stá", "tem", "tic", "tra", "tá ", "uan",
+		"uer", "unt", "ura", "vam", "ver", "vid", "ça ", "ém ", "ós ", " ac", " ai", " aj", " al",
+		" am", " ao", " be", " bo", " br", " cr", " cã", " da", " do", " en", " eu", " fa", " fi",
+		" fo", " fr", " go", " gu", " há", " ig", " ir", " is", " ja", " já", " le", " lí", " ni",
+		" ob", " ol", " op", " or", " pu", " rá", " sã", " tu", " um", " un", " ve", " vi", " vã",
+		" é ", "ach", "aci", "ada", "age", "agi", "agr", "ain", "ais", "aju", "alg", "alq", "alt",
+		"am ", "amb", "amí", "ana", "and", "ans", "ao ", "apo", "arí", "asa", "ass", "ast", "ate",
+		"atu", "ava", "azã", "aça", "bem", "boi", "bon", "bre", "bém", "ca ", "cad", "cam", "can",
+		"car", "ce ", "cem", "cer", "chu", "cim", "cio", "ciê", "coi", "cor", "cra", "cri", "cão",
+		"dam",
+	},
+	"ru": {
+		" по", " на", " в ", "ого", " пр", "го ", " и ", " со", "ми ", " до", "ени", "ли ", "ног",
+		"ть ", " ра", "рав", "ся ", "то ", " бы", " ил", " ка", " ко", " не", " св", "ей ", "ии ",
+		"или", "на ", "обо", "ове", "ост", "пра", "сво", "ств", "сто", " вс", " от", " че", "ать",
+		"бод", "воб", "все", "год", "дел", "дол", "енн", "ест", "ет ", "жен", "ия ", "лов", "льн",
+		"ни ", "нии", "ным", "ода", "одн", "ожд", "олж", "ом ", "пол", "про", "сть", "тве", "шен",
+		"щей", "ыми", "ьно", " во", " де", " др", " ду", " им", " ин", " ли", " мы", " ни", " он",
+		" ст", " то", " чт", "ава", "аде", "ажд", "аль", "ами", "ас ", "аци", "ают", "ая ", "бла",
+		"бы ", "ва ", "ве ", "век", "вен", "во ", "воз", "да ", "ден", "дет", "дру", "ду ", "дый",
+		"ез ", "ек ", "еле", "ели", "ело", "еми", "ен ", "жде", "жды", "ить", "ичн", "ка ", "каж",
+		"как", "ког", "ле ", "лен", "лже", "лич", "мы ", "над", "нас", "не ", "нев", "ния", "нно",
+		"нов", "нош", "ны ", "обл", "оже", "оль", "они", "отн", "оше", "при", "раз", "руг", "сем",
+		"сно", "соб", "сос", "те ", "тно", "тои", "том", "тоя", "тся", "тьс", "ую ", "чел", "что",
+		"ый ", "ься", "это", " бе", " бр", " бу", " ва", " ве", " вз", " вы", " го", " дн", " ес",
+		" ещ", " жд", " жи", " за", " зо", " иг", " к ", " ле", " лу", " лю", " мн", " но", " об",
+		" ре", " ро", " с ", " са", " сд", " се", " ск", " сл", " см", " сн", " те", " уб", " хо",
+		" цв", " эт", " я ", " яз", "абс", "авл", "авн", "аво", "аго", "ада", "аду", "ает", "азл",
+		"азу", "ак ", "ако", "аку", "али", "ам ", "ара", "ари", "аро", "аст", "асы", "атс", "ах ",
+		"аше", "аю ", "бак", "бе ", "беж", "без", "бой", "бра", "бст", "буд", "был", "быс", "вам",
+		"вас", "вах", "вая", "вес", "вет", "вещ", "взя", "вля", "вно", "вны", "вое", "вол", "вую",
+		"вы ", "га ", "гае", "гда", "гии", "гла", "гор", "гра", "дам", "дар", "дат", "даю", "дее",
+		"дек", "дер", "ди ", "дин", "дне", "дны", "дня", "днё", "дож", "дом", "дос", "дум", "дут",
+		"дух", "дую", "дь ", "дёт", "ебе", "ева", "ево", "его", "еде", "еди", "еду", "еем", "еет",
+		"ежд", "езд", "екл", "ект", "ела", "ем ", "емс", "ены", "епр", "ере", "ерж", "еск", "ета",
+		"ете",
+	},
+	"uk": {
+		"ого", " на", " по", "го ", "на ", "ні ", " ко", " пр", " ст", " і ", "ста", "ти ", " в ",
+		" до", "дин", "ови", "ся ", " ві", " не", " со", "льн", "ми ", "нов", "ног", "пра", "рав",
+		"сі ", " аб", " бу", " вс", " лю", " ма", " св", "або", "ати", "бо ", "ва ", "вин", "всі",
+		"від", "год", "дно", "до ", "ку ", "ли ", "люд", "му ", "нас", "нні", "обо", "оди", "ому",
+		"пов", "сво", "соб", "тан", "то ", "тьс", "ті ", "ьно", "ься", "юди", "ють", "ідн", "іст",
+		" ва", " во", " ду", " ді", " ми", " од", " пі", " ра", " ре", " то", " у ", " че", " що",
+		" ін", "ава", "але", "аль", "ано", "ара", "аро", "ас ", "аці", "ают", "ає ", "бод", "буд",
+		"ви ", "воб", "вог", "вон", "віл", "де ", "ди ", "ду ", "енн", "ені", "ере", "жна", "ими",
+		"ина", "инн", "их ", "ичн", "кож", "кол", "не ", "нев", "ни ", "ним", "нь ", "ня ", "оби",
+		"ово", "одж", "одн", "ожн", "оль", "она", "они", "оше", "ою ", "под", "про", "під", "рас",
+		"рог", "ств", "сту", "та ", "ть ", "ціє", "шен", "що ", "яти", "єю ", "іль", "інш", "іти",
+		"ією", " ал", " б ", " ба", " бр", " бі", " вд", " вз", " ви", " го", " гр", " гі", " де",
+		" жи", " з ", " за", " зм", " зн", " зр", " кр", " ле", " ли", " мо", " мі", " ні", " о ",
+		" ос", " па", " пе", " ро", " рі", " са", " сп", " сь", " та", " ти", " хо", " ць", " ці",
+		" чи", " шв", " шк", " ще", " я ", " як", " є ", "абс", "авл", "аво", "ага", "аду", "аді",
+		"айн", "аку", "ам ", "анн", "ань", "ані", "арт", "аси", "асо", "аст", "ате", "ато", "аті",
+		"ах ", "ачо", "ащо", "аю ", "аєм", "баг", "бак", "бає", "бил", "бис", "бою", "бра", "бст",
+		"бут", "бі ", "біл", "вам", "вар", "ват", "вах", "ває", "вде", "взя", "вид", "вищ", "вля",
+		"вни", "во ", "вої", "ву ", "ві ", "віс", "гат", "гол", "гра", "гу ", "гід", "гії", "да ",
+		"дач", "дек", "ден", "дже", "джу", "дка", "дна", "дне", "дні", "доп", "дот", "дощ", "дпр",
+		"дум", "дус", "дяк", "дів", "діл", "діт", "дія", "ева", "еві", "еда", "едо", "ежн", "ез ",
+		"еза", "ей ", "ека", "екл", "еко", "елі", "ен ", "ень", "ерс", "ете", "ече", "жен", "жет",
+		"жит", "жно", "жня", "жую", "за ", "зал", "змо", "зно", "зро", "зум", "зят", "иба", "идк",
+		"ижн",
+	},
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetectLanguage(t *testing.T) {
+	for _, tc := range []struct {
+		s        string
+		script   string
+		language string
+	}{
+		{"Hello, how are you doing today? I hope everything is fine.", "Latin", "en"},
+		{"Guten Morgen, wie geht es Ihnen heute?", "Latin", "de"},
+		{"Bonjour, comment allez-vous aujourd'hui ?", "Latin", "fr"},
+		{"Hola, ¿cómo estás hoy? Espero que todo esté bien.", "Latin", "es"},
+		{"Ciao, come stai oggi? Spero che tutto vada bene.", "Latin", "it"},
+		{"Olá, como você está hoje? Espero que tudo esteja bem.", "Latin", "pt"},
+		{"Goedemorgen, hoe gaat het vandaag met je?", "Latin", "nl"},
+		{"Привет, как у тебя дела сегодня?", "Cyrillic", "ru"},
+		{"Привіт, як у тебе справи сьогодні?", "Cyrillic", "uk"},
+		{"Καλημέρα κόσμε", "Greek", "el"},
+		{"中文文本", "Han", "zh"},
+		// Mostly kanji with one kana: the script is Han, the language Japanese.
+		{"日本語の本", "Han", "ja"},
+		{"ひらがなとカタカナ", "Hiragana", "ja"},
+		{"안녕하세요", "Hangul", "ko"},
+	} {
+		got := detectLanguage(tc.s)
+		assert.Equal(t, tc.script, got.Script, tc.s)
+		if assert.NotEmpty(t, got.Languages, tc.s) {
+			assert.Equal(t, tc.language, got.Languages[0].Language, tc.s)
+		}
+	}
+
+	got := detectLanguage("1234 !?")
+	assert.Equal(t, "", got.Script, "no letters")
+	assert.Empty(t, got.Languages, "no letters")
+}
@@ -1,7 +1,9 @@
 package main
 
 import (
+	"context"
 	"github.com/go-kit/kit/log"
+	"strings"
 	"time"
 )
 
@@ -11,32 +13,368 @@ type loggingMiddleware struct {
 	next StringService
 }
 
-func (mw loggingMiddleware) Uppercase(s string) (output string, err error) {
+func (mw loggingMiddleware) Uppercase(s string, locale string) (output string, err error) {
 	defer func (begin time.Time) {
 		_ = mw.logger.Log(
 			"method", "uppercase",
 			"input", s,
+			"locale", locale,
 			"output", output,
 			"err", err,
 			"took", time.Since(begin),
 		)
 	}(time.Now())
 
-	output, err = mw.next.Uppercase(s)
+	output, err = mw.next.Uppercase(s, locale)
 	return
 }
 
-func (mw loggingMiddleware) Count(s string) (n int64) {
+func (mw loggingMiddleware) Lowercase(s string, locale string) (output string, err error) {
+	defer func(begin time.Time) {
+		_ = mw.logger.Log(
+			"method", "lowercase",
+			"input", s,
+			"locale", locale,
+			"output", output,
+			"err", err,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+
+	output, err = mw.next.Lowercase(s, locale)
+	return
+}
+
+func (mw loggingMiddleware) TitleCase(s string, locale string) (output string, err error) {
+	defer func(begin time.Time) {
+		_ = mw.logger.Log(
+			"method", "titleCase",
+			"input", s,
+			"locale", locale,
+			"output", output,
+			"err", err,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+
+	output, err = mw.next.TitleCase(s, locale)
+	return
+}
+
+func (mw loggingMiddleware) Count(s string, unit string) (n int64, err error) {
 	defer func (begin time.Time) {
 		_ = mw.logger.Log(
 			"method", "count",
 			"input", s,
+			"unit", unit,
 			"n", n,
+			"err", err,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+
+	n, err = mw.next.Count(s, unit)
+	return
+}
+
+func (mw loggingMiddleware) Normalize(s string, opts NormalizeOptions) (output string, err error) {
+	defer func(begin time.Time) {
+		_ = mw.logger.Log(
+			"method", "normalize",
+			"input", s,
+			"form", opts.Form,
+			"caseFold", opts.CaseFold,
+			"stripDiacritics", opts.StripDiacritics,
+			"output", output,
+			"err", err,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+
+	output, err = mw.next.Normalize(s, opts)
+	return
+}
+
+func (mw loggingMiddleware) Analyze(s string) (stats TextStats) {
+	defer func(begin time.Time) {
+		_ = mw.logger.Log(
+			"method", "analyze",
+			"input", s,
+			"runes", stats.Runes,
+			"words", stats.Words,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+
+	stats = mw.next.Analyze(s)
+	return
+}
+
+// Pipeline does not log the input of pipelines with a redact step, which
+// would put the PII they remove in the log.
+func (mw loggingMiddleware) Pipeline(ctx context.Context, s string, steps []PipelineStep, intermediate bool) (result PipelineResult, err error) {
+	defer func(begin time.Time) {
+		input := s
+		for _, step := range steps {
+			if step.Op == "redact" {
+				input = "[redacted]"
+			}
+		}
+		_ = mw.logger.Log(
+			"method", "pipeline",
+			"input", input,
+			"steps", len(steps),
+			"output", result.Output,
+			"err", err,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+
+	result, err = mw.next.Pipeline(ctx, s, steps, intermediate)
+	return
+}
+
+func (mw loggingMiddleware) Replace(s string, opts ReplaceOptions) (output string, err error) {
+	defer func(begin time.Time) {
+		_ = mw.logger.Log(
+			"method", "replace",
+			"input", s,
+			"pattern", opts.Pattern,
+			"replacement", opts.Replacement,
+			"flags", opts.Flags,
+			"output", output,
+			"err", err,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+
+	output, err = mw.next.Replace(s, opts)
+	return
+}
+
+func (mw loggingMiddleware) Extract(s string, opts ExtractOptions) (matches []RegexMatch, err error) {
+	defer func(begin time.Time) {
+		_ = mw.logger.Log(
+			"method", "extract",
+			"input", s,
+			"pattern", opts.Pattern,
+			"flags", opts.Flags,
+			"matches", len(matches),
+			"err", err,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+
+	matches, err = mw.next.Extract(s, opts)
+	return
+}
+
+func (mw loggingMiddleware) Encode(s string, encoding string) (output string, err error) {
+	defer func(begin time.Time) {
+		_ = mw.logger.Log(
+			"method", "encode",
+			"input", s,
+			"encoding", encoding,
+			"output", output,
+			"err", err,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+
+	output, err = mw.next.Encode(s, encoding)
+	return
+}
+
+func (mw loggingMiddleware) Decode(s string, encoding string) (output string, err error) {
+	defer func(begin time.Time) {
+		_ = mw.logger.Log(
+			"method", "decode",
+			"input", s,
+			"encoding", encoding,
+			"output", output,
+			"err", err,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+
+	output, err = mw.next.Decode(s, encoding)
+	return
+}
+
+func (mw loggingMiddleware) Hash(s string, opts HashOptions) (output string, err error) {
+	defer func(begin time.Time) {
+		_ = mw.logger.Log(
+			"method", "hash",
+			"input", s,
+			"algorithm", opts.Algorithm,
+			"outputEncoding", opts.Output,
+			"key", opts.Key,
+			"output", output,
+			"err", err,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+
+	output, err = mw.next.Hash(s, opts)
+	return
+}
+
+func (mw loggingMiddleware) Compare(a string, b string) (result CompareResult, err error) {
+	defer func(begin time.Time) {
+		_ = mw.logger.Log(
+			"method", "compare",
+			"a", a,
+			"b", b,
+			"levenshtein", result.Levenshtein,
+			"similarity", result.Similarity,
+			"err", err,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+
+	result, err = mw.next.Compare(a, b)
+	return
+}
+
+func (mw loggingMiddleware) Diff(a string, b string, opts DiffOptions) (result DiffResult, err error) {
+	defer func(begin time.Time) {
+		_ = mw.logger.Log(
+			"method", "diff",
+			"a", a,
+			"b", b,
+			"mode", opts.Mode,
+			"format", opts.Format,
+			"context", opts.Context,
+			"edits", len(result.Edits),
+			"err", err,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+
+	result, err = mw.next.Diff(a, b, opts)
+	return
+}
+
+func (mw loggingMiddleware) Slugify(s string, opts SlugOptions) (output string, err error) {
+	defer func(begin time.Time) {
+		_ = mw.logger.Log(
+			"method", "slugify",
+			"input", s,
+			"separator", opts.Separator,
+			"maxLength", opts.MaxLength,
+			"existing", len(opts.Existing),
+			"output", output,
+			"err", err,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+
+	output, err = mw.next.Slugify(s, opts)
+	return
+}
+
+func (mw loggingMiddleware) Tokenize(s string, opts TokenizeOptions) (tokens []Token, err error) {
+	defer func(begin time.Time) {
+		_ = mw.logger.Log(
+			"method", "tokenize",
+			"input", s,
+			"unit", opts.Unit,
+			"n", opts.N,
+			"lowercase", opts.Lowercase,
+			"stopWords", opts.StopWords,
+			"tokens", len(tokens),
+			"err", err,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+
+	tokens, err = mw.next.Tokenize(s, opts)
+	return
+}
+
+func (mw loggingMiddleware) Render(tmpl string, data map[string]interface{}) (output string, err error) {
+	defer func(begin time.Time) {
+		_ = mw.logger.Log(
+			"method", "render",
+			"template", tmpl,
+			"output", output,
+			"err", err,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+
+	output, err = mw.next.Render(tmpl, data)
+	return
+}
+
+// Redact does not log its input, which is expected to contain PII.
+func (mw loggingMiddleware) Redact(s string, opts RedactOptions) (result RedactResult, err error) {
+	defer func(begin time.Time) {
+		_ = mw.logger.Log(
+			"method", "redact",
+			"detectors", strings.Join(opts.Detectors, ","),
+			"style", opts.Style,
+			"output", result.Output,
+			"findings", len(result.Findings),
+			"err", err,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+
+	result, err = mw.next.Redact(s, opts)
+	return
+}
+
+func (mw loggingMiddleware) DetectLanguage(s string) (result LanguageResult) {
+	defer func(begin time.Time) {
+		var language string
+		if len(result.Languages) > 0 {
+			language = result.Languages[0].Language
+		}
+		_ = mw.logger.Log(
+			"method", "detectLanguage",
+			"input", s,
+			"script", result.Script,
+			"language", language,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+
+	result = mw.next.DetectLanguage(s)
+	return
+}
+
+func (mw loggingMiddleware) Truncate(s string, opts TruncateOptions) (output string, err error) {
+	defer func(begin time.Time) {
+		_ = mw.logger.Log(
+			"method", "truncate",
+			"input", s,
+			"unit", opts.Unit,
+			"max", opts.Max,
+			"ellipsis", opts.Ellipsis,
+			"output", output,
+			"err", err,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+
+	output, err = mw.next.Truncate(s, opts)
+	return
+}
+
+func (mw loggingMiddleware) Wrap(s string, width int) (output string, err error) {
+	defer func(begin time.Time) {
+		_ = mw.logger.Log(
+			"method", "wrap",
+			"input", s,
+			"width", width,
+			"output", output,
+			"err", err,
 			"took", time.Since(begin),
 		)
 	}(time.Now())
 
-	n = mw.next.Count(s)
+	output, err = mw.next.Wrap(s, width)
 	return
 }
 
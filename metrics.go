@@ -0,0 +1,13 @@
+package main
+
+import (
+	"github.com/go-kit/kit/metrics"
+	kitexpvar "github.com/go-kit/kit/metrics/expvar"
+)
+
+// Metrics are published through expvar and served at GET /debug/vars.
+var (
+	panicsTotal metrics.Counter = kitexpvar.NewCounter("panics_total")
+	cacheHits   metrics.Counter = kitexpvar.NewCounter("cache_hits")
+	cacheMisses metrics.Counter = kitexpvar.NewCounter("cache_misses")
+)
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"errors"
+	"unicode"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+var ErrUnknownForm = errors.New("unknown normalization form")
+
+var normalizationForms = map[string]norm.Form{
+	"NFC":  norm.NFC,
+	"NFD":  norm.NFD,
+	"NFKC": norm.NFKC,
+	"NFKD": norm.NFKD,
+}
+
+// NormalizeOptions selects the Unicode normalization form (NFC by default)
+// and the optional full case folding and diacritics stripping applied before it.
+type NormalizeOptions struct {
+	Form            string
+	CaseFold        bool
+	StripDiacritics bool
+}
+
+func normalizeString(s string, opts NormalizeOptions) (string, error) {
+	form := norm.NFC
+	if opts.Form != "" {
+		f, ok := normalizationForms[opts.Form]
+		if !ok {
+			return "", ErrUnknownForm
+		}
+		form = f
+	}
+
+	var chain []transform.Transformer
+	if opts.CaseFold {
+		chain = append(chain, cases.Fold())
+	}
+	if opts.StripDiacritics {
+		chain = append(chain, norm.NFD, runes.Remove(runes.In(unicode.Mn)))
+	}
+	chain = append(chain, form)
+
+	out, _, err := transform.String(transform.Chain(chain...), s)
+	if err != nil {
+		return "", err
+	}
+	return out, nil
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Lines from the Unicode NormalizationTest.txt conformance file, in its
+// "c1;c2;c3;c4;c5;" format: c2 = NFC, c3 = NFD, c4 = NFKC, c5 = NFKD.
+var normalizationTestVectors = []string{
+	"1E0A;1E0A;0044 0307;1E0A;0044 0307;",
+	"1E0C;1E0C;0044 0323;1E0C;0044 0323;",
+	"1E0A 0323;1E0C 0307;0044 0323 0307;1E0C 0307;0044 0323 0307;",
+	"1E0C 0307;1E0C 0307;0044 0323 0307;1E0C 0307;0044 0323 0307;",
+	"0044 0307 0323;1E0C 0307;0044 0323 0307;1E0C 0307;0044 0323 0307;",
+	"1E9B 0323;1E9B 0323;017F 0323 0307;1E69;0073 0323 0307;",
+	"00C5;00C5;0041 030A;00C5;0041 030A;",
+	"0041 030A;00C5;0041 030A;00C5;0041 030A;",
+	"212B;00C5;0041 030A;00C5;0041 030A;",
+	"2126;03A9;03A9;03A9;03A9;",
+	"FB01;FB01;FB01;0066 0069;0066 0069;",
+	"00BD;00BD;00BD;0031 2044 0032;0031 2044 0032;",
+	"2460;2460;2460;0031;0031;",
+	"FF21;FF21;FF21;0041;0041;",
+	"AC00;AC00;1100 1161;AC00;1100 1161;",
+	"D4DB;D4DB;1111 1171 11B6;D4DB;1111 1171 11B6;",
+}
+
+func parseCodePoints(t *testing.T, field string) string {
+	var b strings.Builder
+	for _, cp := range strings.Fields(field) {
+		r, err := strconv.ParseUint(cp, 16, 32)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b.WriteRune(rune(r))
+	}
+	return b.String()
+}
+
+func TestNormalizeConformance(t *testing.T) {
+	for _, line := range normalizationTestVectors {
+		fields := strings.Split(line, ";")
+		var c [6]string
+		for i := 1; i <= 5; i++ {
+			c[i] = parseCodePoints(t, fields[i-1])
+		}
+
+		tests := []struct {
+			form   string
+			inputs []int
+			want   int
+		}{
+			{"NFC", []int{1, 2, 3}, 2},
+			{"NFC", []int{4, 5}, 4},
+			{"NFD", []int{1, 2, 3}, 3},
+			{"NFD", []int{4, 5}, 5},
+			{"NFKC", []int{1, 2, 3, 4, 5}, 4},
+			{"NFKD", []int{1, 2, 3, 4, 5}, 5},
+		}
+		for _, tt := range tests {
+			for _, in := range tt.inputs {
+				got, err := normalizeString(c[in], NormalizeOptions{Form: tt.form})
+				assert.NoError(t, err)
+				assert.Equal(t, c[tt.want], got, "%s: c%d of %q", tt.form, in, line)
+			}
+		}
+	}
+}
+
+func TestNormalizeOptions(t *testing.T) {
+	tests := []struct {
+		in   string
+		opts NormalizeOptions
+		want string
+		err  error
+	}{
+		{"Crème Brûlée", NormalizeOptions{}, "Crème Brûlée", nil},
+		{"Crème Brûlée", NormalizeOptions{StripDiacritics: true}, "Creme Brulee", nil},
+		{"Straße", NormalizeOptions{CaseFold: true}, "strasse", nil},
+		{"ΣΊΣΥΦΟΣ", NormalizeOptions{CaseFold: true, StripDiacritics: true}, "σισυφοσ", nil},
+		{"ﬁancé", NormalizeOptions{Form: "NFKC", CaseFold: true, StripDiacritics: true}, "fiance", nil},
+		{"abc", NormalizeOptions{Form: "NFX"}, "", ErrUnknownForm},
+	}
+	for _, tt := range tests {
+		got, err := normalizeString(tt.in, tt.opts)
+		assert.Equal(t, tt.err, err, tt.in)
+		assert.Equal(t, tt.want, got, tt.in)
+	}
+}
@@ -26,6 +26,7 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
 type UppercaseRequest struct {
 	S                    string   `protobuf:"bytes,1,opt,name=s,proto3" json:"s,omitempty"`
+	Locale               string   `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -63,6 +64,13 @@ func (m *UppercaseRequest) GetS() string {
 	return ""
 }
 
+func (m *UppercaseRequest) GetLocale() string {
+	if m != nil {
+		return m.Locale
+	}
+	return ""
+}
+
 type UppercaseResponse struct {
 	V                    string   `protobuf:"bytes,1,opt,name=v,proto3" json:"v,omitempty"`
 	Err                  string   `protobuf:"bytes,2,opt,name=err,proto3" json:"err,omitempty"`
@@ -110,8 +118,197 @@ func (m *UppercaseResponse) GetErr() string {
 	return ""
 }
 
+type LowercaseRequest struct {
+	S                    string   `protobuf:"bytes,1,opt,name=s,proto3" json:"s,omitempty"`
+	Locale               string   `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LowercaseRequest) Reset()         { *m = LowercaseRequest{} }
+func (m *LowercaseRequest) String() string { return proto.CompactTextString(m) }
+func (*LowercaseRequest) ProtoMessage()    {}
+func (*LowercaseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8077f7943c5ff, []int{2}
+}
+
+func (m *LowercaseRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LowercaseRequest.Unmarshal(m, b)
+}
+func (m *LowercaseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LowercaseRequest.Marshal(b, m, deterministic)
+}
+func (m *LowercaseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LowercaseRequest.Merge(m, src)
+}
+func (m *LowercaseRequest) XXX_Size() int {
+	return xxx_messageInfo_LowercaseRequest.Size(m)
+}
+func (m *LowercaseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LowercaseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LowercaseRequest proto.InternalMessageInfo
+
+func (m *LowercaseRequest) GetS() string {
+	if m != nil {
+		return m.S
+	}
+	return ""
+}
+
+func (m *LowercaseRequest) GetLocale() string {
+	if m != nil {
+		return m.Locale
+	}
+	return ""
+}
+
+type LowercaseResponse struct {
+	V                    string   `protobuf:"bytes,1,opt,name=v,proto3" json:"v,omitempty"`
+	Err                  string   `protobuf:"bytes,2,opt,name=err,proto3" json:"err,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LowercaseResponse) Reset()         { *m = LowercaseResponse{} }
+func (m *LowercaseResponse) String() string { return proto.CompactTextString(m) }
+func (*LowercaseResponse) ProtoMessage()    {}
+func (*LowercaseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8077f7943c5ff, []int{3}
+}
+
+func (m *LowercaseResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LowercaseResponse.Unmarshal(m, b)
+}
+func (m *LowercaseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LowercaseResponse.Marshal(b, m, deterministic)
+}
+func (m *LowercaseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LowercaseResponse.Merge(m, src)
+}
+func (m *LowercaseResponse) XXX_Size() int {
+	return xxx_messageInfo_LowercaseResponse.Size(m)
+}
+func (m *LowercaseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LowercaseResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LowercaseResponse proto.InternalMessageInfo
+
+func (m *LowercaseResponse) GetV() string {
+	if m != nil {
+		return m.V
+	}
+	return ""
+}
+
+func (m *LowercaseResponse) GetErr() string {
+	if m != nil {
+		return m.Err
+	}
+	return ""
+}
+
+type TitleCaseRequest struct {
+	S                    string   `protobuf:"bytes,1,opt,name=s,proto3" json:"s,omitempty"`
+	Locale               string   `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TitleCaseRequest) Reset()         { *m = TitleCaseRequest{} }
+func (m *TitleCaseRequest) String() string { return proto.CompactTextString(m) }
+func (*TitleCaseRequest) ProtoMessage()    {}
+func (*TitleCaseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8077f7943c5ff, []int{4}
+}
+
+func (m *TitleCaseRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TitleCaseRequest.Unmarshal(m, b)
+}
+func (m *TitleCaseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TitleCaseRequest.Marshal(b, m, deterministic)
+}
+func (m *TitleCaseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TitleCaseRequest.Merge(m, src)
+}
+func (m *TitleCaseRequest) XXX_Size() int {
+	return xxx_messageInfo_TitleCaseRequest.Size(m)
+}
+func (m *TitleCaseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TitleCaseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TitleCaseRequest proto.InternalMessageInfo
+
+func (m *TitleCaseRequest) GetS() string {
+	if m != nil {
+		return m.S
+	}
+	return ""
+}
+
+func (m *TitleCaseRequest) GetLocale() string {
+	if m != nil {
+		return m.Locale
+	}
+	return ""
+}
+
+type TitleCaseResponse struct {
+	V                    string   `protobuf:"bytes,1,opt,name=v,proto3" json:"v,omitempty"`
+	Err                  string   `protobuf:"bytes,2,opt,name=err,proto3" json:"err,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TitleCaseResponse) Reset()         { *m = TitleCaseResponse{} }
+func (m *TitleCaseResponse) String() string { return proto.CompactTextString(m) }
+func (*TitleCaseResponse) ProtoMessage()    {}
+func (*TitleCaseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8077f7943c5ff, []int{5}
+}
+
+func (m *TitleCaseResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TitleCaseResponse.Unmarshal(m, b)
+}
+func (m *TitleCaseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TitleCaseResponse.Marshal(b, m, deterministic)
+}
+func (m *TitleCaseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TitleCaseResponse.Merge(m, src)
+}
+func (m *TitleCaseResponse) XXX_Size() int {
+	return xxx_messageInfo_TitleCaseResponse.Size(m)
+}
+func (m *TitleCaseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TitleCaseResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TitleCaseResponse proto.InternalMessageInfo
+
+func (m *TitleCaseResponse) GetV() string {
+	if m != nil {
+		return m.V
+	}
+	return ""
+}
+
+func (m *TitleCaseResponse) GetErr() string {
+	if m != nil {
+		return m.Err
+	}
+	return ""
+}
+
 type CountRequest struct {
 	S                    string   `protobuf:"bytes,1,opt,name=s,proto3" json:"s,omitempty"`
+	Unit                 string   `protobuf:"bytes,2,opt,name=unit,proto3" json:"unit,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -121,7 +318,7 @@ func (m *CountRequest) Reset()         { *m = CountRequest{} }
 func (m *CountRequest) String() string { return proto.CompactTextString(m) }
 func (*CountRequest) ProtoMessage()    {}
 func (*CountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02f8077f7943c5ff, []int{2}
+	return fileDescriptor_02f8077f7943c5ff, []int{6}
 }
 
 func (m *CountRequest) XXX_Unmarshal(b []byte) error {
@@ -149,8 +346,16 @@ func (m *CountRequest) GetS() string {
 	return ""
 }
 
+func (m *CountRequest) GetUnit() string {
+	if m != nil {
+		return m.Unit
+	}
+	return ""
+}
+
 type CountResponse struct {
 	V                    int64    `protobuf:"varint,1,opt,name=v,proto3" json:"v,omitempty"`
+	Err                  string   `protobuf:"bytes,2,opt,name=err,proto3" json:"err,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -160,7 +365,7 @@ func (m *CountResponse) Reset()         { *m = CountResponse{} }
 func (m *CountResponse) String() string { return proto.CompactTextString(m) }
 func (*CountResponse) ProtoMessage()    {}
 func (*CountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02f8077f7943c5ff, []int{3}
+	return fileDescriptor_02f8077f7943c5ff, []int{7}
 }
 
 func (m *CountResponse) XXX_Unmarshal(b []byte) error {
@@ -188,241 +393,4059 @@ func (m *CountResponse) GetV() int64 {
 	return 0
 }
 
-type AuthRequest struct {
-	Username             string   `protobuf:"bytes,1,opt,name=Username,proto3" json:"Username,omitempty"`
-	Password             string   `protobuf:"bytes,2,opt,name=Password,proto3" json:"Password,omitempty"`
+func (m *CountResponse) GetErr() string {
+	if m != nil {
+		return m.Err
+	}
+	return ""
+}
+
+type NormalizeRequest struct {
+	S                    string   `protobuf:"bytes,1,opt,name=s,proto3" json:"s,omitempty"`
+	Form                 string   `protobuf:"bytes,2,opt,name=form,proto3" json:"form,omitempty"`
+	CaseFold             bool     `protobuf:"varint,3,opt,name=case_fold,json=caseFold,proto3" json:"case_fold,omitempty"`
+	StripDiacritics      bool     `protobuf:"varint,4,opt,name=strip_diacritics,json=stripDiacritics,proto3" json:"strip_diacritics,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AuthRequest) Reset()         { *m = AuthRequest{} }
-func (m *AuthRequest) String() string { return proto.CompactTextString(m) }
-func (*AuthRequest) ProtoMessage()    {}
-func (*AuthRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02f8077f7943c5ff, []int{4}
+func (m *NormalizeRequest) Reset()         { *m = NormalizeRequest{} }
+func (m *NormalizeRequest) String() string { return proto.CompactTextString(m) }
+func (*NormalizeRequest) ProtoMessage()    {}
+func (*NormalizeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8077f7943c5ff, []int{8}
 }
 
-func (m *AuthRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AuthRequest.Unmarshal(m, b)
+func (m *NormalizeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NormalizeRequest.Unmarshal(m, b)
 }
-func (m *AuthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AuthRequest.Marshal(b, m, deterministic)
+func (m *NormalizeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NormalizeRequest.Marshal(b, m, deterministic)
 }
-func (m *AuthRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AuthRequest.Merge(m, src)
+func (m *NormalizeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NormalizeRequest.Merge(m, src)
 }
-func (m *AuthRequest) XXX_Size() int {
-	return xxx_messageInfo_AuthRequest.Size(m)
+func (m *NormalizeRequest) XXX_Size() int {
+	return xxx_messageInfo_NormalizeRequest.Size(m)
 }
-func (m *AuthRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_AuthRequest.DiscardUnknown(m)
+func (m *NormalizeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NormalizeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AuthRequest proto.InternalMessageInfo
+var xxx_messageInfo_NormalizeRequest proto.InternalMessageInfo
 
-func (m *AuthRequest) GetUsername() string {
+func (m *NormalizeRequest) GetS() string {
 	if m != nil {
-		return m.Username
+		return m.S
 	}
 	return ""
 }
 
-func (m *AuthRequest) GetPassword() string {
+func (m *NormalizeRequest) GetForm() string {
 	if m != nil {
-		return m.Password
+		return m.Form
 	}
 	return ""
 }
 
-type AuthResponse struct {
-	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+func (m *NormalizeRequest) GetCaseFold() bool {
+	if m != nil {
+		return m.CaseFold
+	}
+	return false
+}
+
+func (m *NormalizeRequest) GetStripDiacritics() bool {
+	if m != nil {
+		return m.StripDiacritics
+	}
+	return false
+}
+
+type NormalizeResponse struct {
+	V                    string   `protobuf:"bytes,1,opt,name=v,proto3" json:"v,omitempty"`
 	Err                  string   `protobuf:"bytes,2,opt,name=err,proto3" json:"err,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AuthResponse) Reset()         { *m = AuthResponse{} }
-func (m *AuthResponse) String() string { return proto.CompactTextString(m) }
-func (*AuthResponse) ProtoMessage()    {}
-func (*AuthResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02f8077f7943c5ff, []int{5}
+func (m *NormalizeResponse) Reset()         { *m = NormalizeResponse{} }
+func (m *NormalizeResponse) String() string { return proto.CompactTextString(m) }
+func (*NormalizeResponse) ProtoMessage()    {}
+func (*NormalizeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8077f7943c5ff, []int{9}
 }
 
-func (m *AuthResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AuthResponse.Unmarshal(m, b)
+func (m *NormalizeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NormalizeResponse.Unmarshal(m, b)
 }
-func (m *AuthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AuthResponse.Marshal(b, m, deterministic)
+func (m *NormalizeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NormalizeResponse.Marshal(b, m, deterministic)
 }
-func (m *AuthResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AuthResponse.Merge(m, src)
+func (m *NormalizeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NormalizeResponse.Merge(m, src)
 }
-func (m *AuthResponse) XXX_Size() int {
-	return xxx_messageInfo_AuthResponse.Size(m)
+func (m *NormalizeResponse) XXX_Size() int {
+	return xxx_messageInfo_NormalizeResponse.Size(m)
 }
-func (m *AuthResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_AuthResponse.DiscardUnknown(m)
+func (m *NormalizeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_NormalizeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AuthResponse proto.InternalMessageInfo
+var xxx_messageInfo_NormalizeResponse proto.InternalMessageInfo
 
-func (m *AuthResponse) GetToken() string {
+func (m *NormalizeResponse) GetV() string {
 	if m != nil {
-		return m.Token
+		return m.V
 	}
 	return ""
 }
 
-func (m *AuthResponse) GetErr() string {
+func (m *NormalizeResponse) GetErr() string {
 	if m != nil {
 		return m.Err
 	}
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*UppercaseRequest)(nil), "pb.UppercaseRequest")
-	proto.RegisterType((*UppercaseResponse)(nil), "pb.UppercaseResponse")
-	proto.RegisterType((*CountRequest)(nil), "pb.CountRequest")
-	proto.RegisterType((*CountResponse)(nil), "pb.CountResponse")
-	proto.RegisterType((*AuthRequest)(nil), "pb.AuthRequest")
-	proto.RegisterType((*AuthResponse)(nil), "pb.AuthResponse")
+type AnalyzeRequest struct {
+	S                    string   `protobuf:"bytes,1,opt,name=s,proto3" json:"s,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() { proto.RegisterFile("stringsvc.proto", fileDescriptor_02f8077f7943c5ff) }
+func (m *AnalyzeRequest) Reset()         { *m = AnalyzeRequest{} }
+func (m *AnalyzeRequest) String() string { return proto.CompactTextString(m) }
+func (*AnalyzeRequest) ProtoMessage()    {}
+func (*AnalyzeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8077f7943c5ff, []int{10}
+}
 
-var fileDescriptor_02f8077f7943c5ff = []byte{
-	// 266 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0x51, 0x4b, 0xc3, 0x30,
-	0x10, 0xc7, 0xcd, 0xe6, 0xc4, 0x9d, 0x1d, 0xeb, 0xc2, 0x84, 0x51, 0x14, 0x46, 0x9e, 0x04, 0xa1,
-	0x0f, 0x0e, 0x7c, 0xf0, 0x4d, 0xc4, 0x77, 0xe9, 0xd8, 0x07, 0x68, 0xeb, 0xa1, 0x45, 0x4c, 0x62,
-	0x2e, 0x8d, 0x5f, 0xc9, 0x8f, 0x29, 0x69, 0xd3, 0x5a, 0xea, 0xde, 0xf2, 0xbf, 0xfb, 0xe5, 0x7f,
-	0x97, 0x7f, 0x60, 0x49, 0xd6, 0x54, 0xf2, 0x8d, 0x5c, 0x99, 0x6a, 0xa3, 0xac, 0xe2, 0x13, 0x5d,
-	0x88, 0x2d, 0xc4, 0x07, 0xad, 0xd1, 0x94, 0x39, 0x61, 0x86, 0x5f, 0x35, 0x92, 0xe5, 0x11, 0x30,
-	0xda, 0xb0, 0x2d, 0xbb, 0x99, 0x67, 0x8c, 0xc4, 0x0e, 0x56, 0x03, 0x82, 0xb4, 0x92, 0x84, 0x1e,
-	0x71, 0x1d, 0xe2, 0x78, 0x0c, 0x53, 0x34, 0x66, 0x33, 0x69, 0xb4, 0x3f, 0x8a, 0x2b, 0x88, 0x9e,
-	0x54, 0x2d, 0xed, 0x71, 0xcb, 0x6b, 0x58, 0x84, 0xee, 0xd8, 0x6e, 0x9a, 0x31, 0x27, 0x9e, 0xe1,
-	0xe2, 0xb1, 0xb6, 0xef, 0xdd, 0xdd, 0x04, 0xce, 0x0f, 0x84, 0x46, 0xe6, 0x9f, 0x18, 0x2c, 0x7a,
-	0xed, 0x7b, 0x2f, 0x39, 0xd1, 0xb7, 0x32, 0xaf, 0x61, 0x7c, 0xaf, 0xc5, 0x3d, 0x44, 0xad, 0x4d,
-	0x18, 0xb2, 0x86, 0x99, 0x55, 0x1f, 0x28, 0x83, 0x49, 0x2b, 0xfe, 0xef, 0x7e, 0xf7, 0xc3, 0x60,
-	0xb1, 0x6f, 0xa2, 0xda, 0xa3, 0x71, 0x55, 0x89, 0xfc, 0x01, 0xe6, 0x7d, 0x04, 0x7c, 0x9d, 0xea,
-	0x22, 0x1d, 0x67, 0x96, 0x5c, 0x8e, 0xaa, 0xed, 0x4c, 0x71, 0xc2, 0x53, 0x98, 0x35, 0x6f, 0xe5,
-	0xb1, 0x27, 0x86, 0xa1, 0x24, 0xab, 0x41, 0xa5, 0xe7, 0x6f, 0xe1, 0xd4, 0x6f, 0xcd, 0x97, 0xbe,
-	0x39, 0x88, 0x21, 0x89, 0xff, 0x0a, 0x1d, 0x5c, 0x9c, 0x35, 0x1f, 0xb9, 0xfb, 0x0d, 0x00, 0x00,
-	0xff, 0xff, 0x7f, 0x81, 0xfd, 0xaa, 0xdb, 0x01, 0x00, 0x00,
+func (m *AnalyzeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AnalyzeRequest.Unmarshal(m, b)
+}
+func (m *AnalyzeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AnalyzeRequest.Marshal(b, m, deterministic)
+}
+func (m *AnalyzeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AnalyzeRequest.Merge(m, src)
+}
+func (m *AnalyzeRequest) XXX_Size() int {
+	return xxx_messageInfo_AnalyzeRequest.Size(m)
+}
+func (m *AnalyzeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AnalyzeRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_AnalyzeRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *AnalyzeRequest) GetS() string {
+	if m != nil {
+		return m.S
+	}
+	return ""
+}
 
-// StringServiceClient is the client API for StringService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type StringServiceClient interface {
-	Uppercase(ctx context.Context, in *UppercaseRequest, opts ...grpc.CallOption) (*UppercaseResponse, error)
-	Count(ctx context.Context, in *CountRequest, opts ...grpc.CallOption) (*CountResponse, error)
-	Auth(ctx context.Context, in *AuthRequest, opts ...grpc.CallOption) (*AuthResponse, error)
+type AnalyzeResponse struct {
+	Bytes                int64            `protobuf:"varint,1,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	Runes                int64            `protobuf:"varint,2,opt,name=runes,proto3" json:"runes,omitempty"`
+	Graphemes            int64            `protobuf:"varint,3,opt,name=graphemes,proto3" json:"graphemes,omitempty"`
+	Words                int64            `protobuf:"varint,4,opt,name=words,proto3" json:"words,omitempty"`
+	Sentences            int64            `protobuf:"varint,5,opt,name=sentences,proto3" json:"sentences,omitempty"`
+	Lines                int64            `protobuf:"varint,6,opt,name=lines,proto3" json:"lines,omitempty"`
+	Whitespace           int64            `protobuf:"varint,7,opt,name=whitespace,proto3" json:"whitespace,omitempty"`
+	Distinct             int64            `protobuf:"varint,8,opt,name=distinct,proto3" json:"distinct,omitempty"`
+	Scripts              []string         `protobuf:"bytes,9,rep,name=scripts,proto3" json:"scripts,omitempty"`
+	Frequency            map[string]int64 `protobuf:"bytes,10,rep,name=frequency,proto3" json:"frequency,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-type stringServiceClient struct {
-	cc *grpc.ClientConn
+func (m *AnalyzeResponse) Reset()         { *m = AnalyzeResponse{} }
+func (m *AnalyzeResponse) String() string { return proto.CompactTextString(m) }
+func (*AnalyzeResponse) ProtoMessage()    {}
+func (*AnalyzeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8077f7943c5ff, []int{11}
 }
 
-func NewStringServiceClient(cc *grpc.ClientConn) StringServiceClient {
-	return &stringServiceClient{cc}
+func (m *AnalyzeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AnalyzeResponse.Unmarshal(m, b)
+}
+func (m *AnalyzeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AnalyzeResponse.Marshal(b, m, deterministic)
+}
+func (m *AnalyzeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AnalyzeResponse.Merge(m, src)
+}
+func (m *AnalyzeResponse) XXX_Size() int {
+	return xxx_messageInfo_AnalyzeResponse.Size(m)
+}
+func (m *AnalyzeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AnalyzeResponse.DiscardUnknown(m)
 }
 
-func (c *stringServiceClient) Uppercase(ctx context.Context, in *UppercaseRequest, opts ...grpc.CallOption) (*UppercaseResponse, error) {
-	out := new(UppercaseResponse)
-	err := c.cc.Invoke(ctx, "/pb.StringService/Uppercase", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_AnalyzeResponse proto.InternalMessageInfo
+
+func (m *AnalyzeResponse) GetBytes() int64 {
+	if m != nil {
+		return m.Bytes
 	}
-	return out, nil
+	return 0
 }
 
-func (c *stringServiceClient) Count(ctx context.Context, in *CountRequest, opts ...grpc.CallOption) (*CountResponse, error) {
-	out := new(CountResponse)
-	err := c.cc.Invoke(ctx, "/pb.StringService/Count", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *AnalyzeResponse) GetRunes() int64 {
+	if m != nil {
+		return m.Runes
 	}
-	return out, nil
+	return 0
 }
 
-func (c *stringServiceClient) Auth(ctx context.Context, in *AuthRequest, opts ...grpc.CallOption) (*AuthResponse, error) {
-	out := new(AuthResponse)
-	err := c.cc.Invoke(ctx, "/pb.StringService/Auth", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *AnalyzeResponse) GetGraphemes() int64 {
+	if m != nil {
+		return m.Graphemes
 	}
-	return out, nil
+	return 0
+}
+
+func (m *AnalyzeResponse) GetWords() int64 {
+	if m != nil {
+		return m.Words
+	}
+	return 0
+}
+
+func (m *AnalyzeResponse) GetSentences() int64 {
+	if m != nil {
+		return m.Sentences
+	}
+	return 0
+}
+
+func (m *AnalyzeResponse) GetLines() int64 {
+	if m != nil {
+		return m.Lines
+	}
+	return 0
+}
+
+func (m *AnalyzeResponse) GetWhitespace() int64 {
+	if m != nil {
+		return m.Whitespace
+	}
+	return 0
+}
+
+func (m *AnalyzeResponse) GetDistinct() int64 {
+	if m != nil {
+		return m.Distinct
+	}
+	return 0
+}
+
+func (m *AnalyzeResponse) GetScripts() []string {
+	if m != nil {
+		return m.Scripts
+	}
+	return nil
+}
+
+func (m *AnalyzeResponse) GetFrequency() map[string]int64 {
+	if m != nil {
+		return m.Frequency
+	}
+	return nil
+}
+
+type BatchItem struct {
+	Op                   string   `protobuf:"bytes,1,opt,name=op,proto3" json:"op,omitempty"`
+	S                    string   `protobuf:"bytes,2,opt,name=s,proto3" json:"s,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BatchItem) Reset()         { *m = BatchItem{} }
+func (m *BatchItem) String() string { return proto.CompactTextString(m) }
+func (*BatchItem) ProtoMessage()    {}
+func (*BatchItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8077f7943c5ff, []int{12}
+}
+
+func (m *BatchItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchItem.Unmarshal(m, b)
+}
+func (m *BatchItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchItem.Marshal(b, m, deterministic)
+}
+func (m *BatchItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchItem.Merge(m, src)
+}
+func (m *BatchItem) XXX_Size() int {
+	return xxx_messageInfo_BatchItem.Size(m)
+}
+func (m *BatchItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchItem proto.InternalMessageInfo
+
+func (m *BatchItem) GetOp() string {
+	if m != nil {
+		return m.Op
+	}
+	return ""
+}
+
+func (m *BatchItem) GetS() string {
+	if m != nil {
+		return m.S
+	}
+	return ""
+}
+
+type BatchResult struct {
+	V                    string   `protobuf:"bytes,1,opt,name=v,proto3" json:"v,omitempty"`
+	N                    int64    `protobuf:"varint,2,opt,name=n,proto3" json:"n,omitempty"`
+	Err                  string   `protobuf:"bytes,3,opt,name=err,proto3" json:"err,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BatchResult) Reset()         { *m = BatchResult{} }
+func (m *BatchResult) String() string { return proto.CompactTextString(m) }
+func (*BatchResult) ProtoMessage()    {}
+func (*BatchResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8077f7943c5ff, []int{13}
+}
+
+func (m *BatchResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchResult.Unmarshal(m, b)
+}
+func (m *BatchResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchResult.Marshal(b, m, deterministic)
+}
+func (m *BatchResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchResult.Merge(m, src)
+}
+func (m *BatchResult) XXX_Size() int {
+	return xxx_messageInfo_BatchResult.Size(m)
+}
+func (m *BatchResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchResult proto.InternalMessageInfo
+
+func (m *BatchResult) GetV() string {
+	if m != nil {
+		return m.V
+	}
+	return ""
+}
+
+func (m *BatchResult) GetN() int64 {
+	if m != nil {
+		return m.N
+	}
+	return 0
+}
+
+func (m *BatchResult) GetErr() string {
+	if m != nil {
+		return m.Err
+	}
+	return ""
+}
+
+type BatchRequest struct {
+	Items                []*BatchItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *BatchRequest) Reset()         { *m = BatchRequest{} }
+func (m *BatchRequest) String() string { return proto.CompactTextString(m) }
+func (*BatchRequest) ProtoMessage()    {}
+func (*BatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8077f7943c5ff, []int{14}
+}
+
+func (m *BatchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchRequest.Unmarshal(m, b)
+}
+func (m *BatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchRequest.Marshal(b, m, deterministic)
+}
+func (m *BatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchRequest.Merge(m, src)
+}
+func (m *BatchRequest) XXX_Size() int {
+	return xxx_messageInfo_BatchRequest.Size(m)
+}
+func (m *BatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchRequest proto.InternalMessageInfo
+
+func (m *BatchRequest) GetItems() []*BatchItem {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type BatchResponse struct {
+	Results              []*BatchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *BatchResponse) Reset()         { *m = BatchResponse{} }
+func (m *BatchResponse) String() string { return proto.CompactTextString(m) }
+func (*BatchResponse) ProtoMessage()    {}
+func (*BatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8077f7943c5ff, []int{15}
+}
+
+func (m *BatchResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchResponse.Unmarshal(m, b)
+}
+func (m *BatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchResponse.Marshal(b, m, deterministic)
+}
+func (m *BatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchResponse.Merge(m, src)
+}
+func (m *BatchResponse) XXX_Size() int {
+	return xxx_messageInfo_BatchResponse.Size(m)
+}
+func (m *BatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchResponse proto.InternalMessageInfo
+
+func (m *BatchResponse) GetResults() []*BatchResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+type TransformRequest struct {
+	Op                   string   `protobuf:"bytes,1,opt,name=op,proto3" json:"op,omitempty"`
+	S                    string   `protobuf:"bytes,2,opt,name=s,proto3" json:"s,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TransformRequest) Reset()         { *m = TransformRequest{} }
+func (m *TransformRequest) String() string { return proto.CompactTextString(m) }
+func (*TransformRequest) ProtoMessage()    {}
+func (*TransformRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8077f7943c5ff, []int{16}
+}
+
+func (m *TransformRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransformRequest.Unmarshal(m, b)
+}
+func (m *TransformRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransformRequest.Marshal(b, m, deterministic)
+}
+func (m *TransformRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransformRequest.Merge(m, src)
+}
+func (m *TransformRequest) XXX_Size() int {
+	return xxx_messageInfo_TransformRequest.Size(m)
+}
+func (m *TransformRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransformRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransformRequest proto.InternalMessageInfo
+
+func (m *TransformRequest) GetOp() string {
+	if m != nil {
+		return m.Op
+	}
+	return ""
+}
+
+func (m *TransformRequest) GetS() string {
+	if m != nil {
+		return m.S
+	}
+	return ""
+}
+
+type TransformResponse struct {
+	V                    string   `protobuf:"bytes,1,opt,name=v,proto3" json:"v,omitempty"`
+	N                    int64    `protobuf:"varint,2,opt,name=n,proto3" json:"n,omitempty"`
+	Err                  string   `protobuf:"bytes,3,opt,name=err,proto3" json:"err,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TransformResponse) Reset()         { *m = TransformResponse{} }
+func (m *TransformResponse) String() string { return proto.CompactTextString(m) }
+func (*TransformResponse) ProtoMessage()    {}
+func (*TransformResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8077f7943c5ff, []int{17}
+}
+
+func (m *TransformResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransformResponse.Unmarshal(m, b)
+}
+func (m *TransformResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransformResponse.Marshal(b, m, deterministic)
+}
+func (m *TransformResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransformResponse.Merge(m, src)
+}
+func (m *TransformResponse) XXX_Size() int {
+	return xxx_messageInfo_TransformResponse.Size(m)
+}
+func (m *TransformResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransformResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransformResponse proto.InternalMessageInfo
+
+func (m *TransformResponse) GetV() string {
+	if m != nil {
+		return m.V
+	}
+	return ""
+}
+
+func (m *TransformResponse) GetN() int64 {
+	if m != nil {
+		return m.N
+	}
+	return 0
+}
+
+func (m *TransformResponse) GetErr() string {
+	if m != nil {
+		return m.Err
+	}
+	return ""
+}
+
+type PipelineStep struct {
+	Op                   string            `protobuf:"bytes,1,opt,name=op,proto3" json:"op,omitempty"`
+	Params               map[string]string `protobuf:"bytes,2,rep,name=params,proto3" json:"params,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *PipelineStep) Reset()         { *m = PipelineStep{} }
+func (m *PipelineStep) String() string { return proto.CompactTextString(m) }
+func (*PipelineStep) ProtoMessage()    {}
+func (*PipelineStep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8077f7943c5ff, []int{18}
+}
+
+func (m *PipelineStep) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PipelineStep.Unmarshal(m, b)
+}
+func (m *PipelineStep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PipelineStep.Marshal(b, m, deterministic)
+}
+func (m *PipelineStep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PipelineStep.Merge(m, src)
+}
+func (m *PipelineStep) XXX_Size() int {
+	return xxx_messageInfo_PipelineStep.Size(m)
+}
+func (m *PipelineStep) XXX_DiscardUnknown() {
+	xxx_messageInfo_PipelineStep.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PipelineStep proto.InternalMessageInfo
+
+func (m *PipelineStep) GetOp() string {
+	if m != nil {
+		return m.Op
+	}
+	return ""
+}
+
+func (m *PipelineStep) GetParams() map[string]string {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
+type PipelineRequest struct {
+	S                    string          `protobuf:"bytes,1,opt,name=s,proto3" json:"s,omitempty"`
+	Steps                []*PipelineStep `protobuf:"bytes,2,rep,name=steps,proto3" json:"steps,omitempty"`
+	Intermediate         bool            `protobuf:"varint,3,opt,name=intermediate,proto3" json:"intermediate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *PipelineRequest) Reset()         { *m = PipelineRequest{} }
+func (m *PipelineRequest) String() string { return proto.CompactTextString(m) }
+func (*PipelineRequest) ProtoMessage()    {}
+func (*PipelineRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8077f7943c5ff, []int{19}
+}
+
+func (m *PipelineRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PipelineRequest.Unmarshal(m, b)
+}
+func (m *PipelineRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PipelineRequest.Marshal(b, m, deterministic)
+}
+func (m *PipelineRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PipelineRequest.Merge(m, src)
+}
+func (m *PipelineRequest) XXX_Size() int {
+	return xxx_messageInfo_PipelineRequest.Size(m)
+}
+func (m *PipelineRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PipelineRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PipelineRequest proto.InternalMessageInfo
+
+func (m *PipelineRequest) GetS() string {
+	if m != nil {
+		return m.S
+	}
+	return ""
+}
+
+func (m *PipelineRequest) GetSteps() []*PipelineStep {
+	if m != nil {
+		return m.Steps
+	}
+	return nil
+}
+
+func (m *PipelineRequest) GetIntermediate() bool {
+	if m != nil {
+		return m.Intermediate
+	}
+	return false
+}
+
+type PipelineResponse struct {
+	V                    string   `protobuf:"bytes,1,opt,name=v,proto3" json:"v,omitempty"`
+	Intermediate         []string `protobuf:"bytes,2,rep,name=intermediate,proto3" json:"intermediate,omitempty"`
+	Err                  string   `protobuf:"bytes,3,opt,name=err,proto3" json:"err,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PipelineResponse) Reset()         { *m = PipelineResponse{} }
+func (m *PipelineResponse) String() string { return proto.CompactTextString(m) }
+func (*PipelineResponse) ProtoMessage()    {}
+func (*PipelineResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8077f7943c5ff, []int{20}
+}
+
+func (m *PipelineResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PipelineResponse.Unmarshal(m, b)
+}
+func (m *PipelineResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PipelineResponse.Marshal(b, m, deterministic)
+}
+func (m *PipelineResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PipelineResponse.Merge(m, src)
+}
+func (m *PipelineResponse) XXX_Size() int {
+	return xxx_messageInfo_PipelineResponse.Size(m)
+}
+func (m *PipelineResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PipelineResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PipelineResponse proto.InternalMessageInfo
+
+func (m *PipelineResponse) GetV() string {
+	if m != nil {
+		return m.V
+	}
+	return ""
+}
+
+func (m *PipelineResponse) GetIntermediate() []string {
+	if m != nil {
+		return m.Intermediate
+	}
+	return nil
+}
+
+func (m *PipelineResponse) GetErr() string {
+	if m != nil {
+		return m.Err
+	}
+	return ""
+}
+
+type ReplaceRequest struct {
+	S                    string   `protobuf:"bytes,1,opt,name=s,proto3" json:"s,omitempty"`
+	Pattern              string   `protobuf:"bytes,2,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	Replacement          string   `protobuf:"bytes,3,opt,name=replacement,proto3" json:"replacement,omitempty"`
+	Flags                string   `protobuf:"bytes,4,opt,name=flags,proto3" json:"flags,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReplaceRequest) Reset()         { *m = ReplaceRequest{} }
+func (m *ReplaceRequest) String() string { return proto.CompactTextString(m) }
+func (*ReplaceRequest) ProtoMessage()    {}
+func (*ReplaceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8077f7943c5ff, []int{21}
+}
+
+func (m *ReplaceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplaceRequest.Unmarshal(m, b)
+}
+func (m *ReplaceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplaceRequest.Marshal(b, m, deterministic)
+}
+func (m *ReplaceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplaceRequest.Merge(m, src)
+}
+func (m *ReplaceRequest) XXX_Size() int {
+	return xxx_messageInfo_ReplaceRequest.Size(m)
+}
+func (m *ReplaceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplaceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplaceRequest proto.InternalMessageInfo
+
+func (m *ReplaceRequest) GetS() string {
+	if m != nil {
+		return m.S
+	}
+	return ""
+}
+
+func (m *ReplaceRequest) GetPattern() string {
+	if m != nil {
+		return m.Pattern
+	}
+	return ""
+}
+
+func (m *ReplaceRequest) GetReplacement() string {
+	if m != nil {
+		return m.Replacement
+	}
+	return ""
+}
+
+func (m *ReplaceRequest) GetFlags() string {
+	if m != nil {
+		return m.Flags
+	}
+	return ""
+}
+
+type ReplaceResponse struct {
+	V                    string   `protobuf:"bytes,1,opt,name=v,proto3" json:"v,omitempty"`
+	Err                  string   `protobuf:"bytes,2,opt,name=err,proto3" json:"err,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReplaceResponse) Reset()         { *m = ReplaceResponse{} }
+func (m *ReplaceResponse) String() string { return proto.CompactTextString(m) }
+func (*ReplaceResponse) ProtoMessage()    {}
+func (*ReplaceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8077f7943c5ff, []int{22}
+}
+
+func (m *ReplaceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplaceResponse.Unmarshal(m, b)
+}
+func (m *ReplaceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplaceResponse.Marshal(b, m, deterministic)
+}
+func (m *ReplaceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplaceResponse.Merge(m, src)
+}
+func (m *ReplaceResponse) XXX_Size() int {
+	return xxx_messageInfo_ReplaceResponse.Size(m)
+}
+func (m *ReplaceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplaceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplaceResponse proto.InternalMessageInfo
+
+func (m *ReplaceResponse) GetV() string {
+	if m != nil {
+		return m.V
+	}
+	return ""
+}
+
+func (m *ReplaceResponse) GetErr() string {
+	if m != nil {
+		return m.Err
+	}
+	return ""
+}
+
+type ExtractRequest struct {
+	S                    string   `protobuf:"bytes,1,opt,name=s,proto3" json:"s,omitempty"`
+	Pattern              string   `protobuf:"bytes,2,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	Flags                string   `protobuf:"bytes,3,opt,name=flags,proto3" json:"flags,omitempty"`
+	Limit                int32    `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExtractRequest) Reset()         { *m = ExtractRequest{} }
+func (m *ExtractRequest) String() string { return proto.CompactTextString(m) }
+func (*ExtractRequest) ProtoMessage()    {}
+func (*ExtractRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8077f7943c5ff, []int{23}
+}
+
+func (m *ExtractRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExtractRequest.Unmarshal(m, b)
+}
+func (m *ExtractRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExtractRequest.Marshal(b, m, deterministic)
+}
+func (m *ExtractRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtractRequest.Merge(m, src)
+}
+func (m *ExtractRequest) XXX_Size() int {
+	return xxx_messageInfo_ExtractRequest.Size(m)
+}
+func (m *ExtractRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtractRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExtractRequest proto.InternalMessageInfo
+
+func (m *ExtractRequest) GetS() string {
+	if m != nil {
+		return m.S
+	}
+	return ""
+}
+
+func (m *ExtractRequest) GetPattern() string {
+	if m != nil {
+		return m.Pattern
+	}
+	return ""
+}
+
+func (m *ExtractRequest) GetFlags() string {
+	if m != nil {
+		return m.Flags
+	}
+	return ""
+}
+
+func (m *ExtractRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type RegexMatch struct {
+	Text                 string            `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Start                int64             `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	End                  int64             `protobuf:"varint,3,opt,name=end,proto3" json:"end,omitempty"`
+	Groups               []string          `protobuf:"bytes,4,rep,name=groups,proto3" json:"groups,omitempty"`
+	Named                map[string]string `protobuf:"bytes,5,rep,name=named,proto3" json:"named,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *RegexMatch) Reset()         { *m = RegexMatch{} }
+func (m *RegexMatch) String() string { return proto.CompactTextString(m) }
+func (*RegexMatch) ProtoMessage()    {}
+func (*RegexMatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8077f7943c5ff, []int{24}
+}
+
+func (m *RegexMatch) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegexMatch.Unmarshal(m, b)
+}
+func (m *RegexMatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegexMatch.Marshal(b, m, deterministic)
+}
+func (m *RegexMatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegexMatch.Merge(m, src)
+}
+func (m *RegexMatch) XXX_Size() int {
+	return xxx_messageInfo_RegexMatch.Size(m)
+}
+func (m *RegexMatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegexMatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegexMatch proto.InternalMessageInfo
+
+func (m *RegexMatch) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
+}
+
+func (m *RegexMatch) GetStart() int64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *RegexMatch) GetEnd() int64 {
+	if m != nil {
+		return m.End
+	}
+	return 0
+}
+
+func (m *RegexMatch) GetGroups() []string {
+	if m != nil {
+		return m.Groups
+	}
+	return nil
+}
+
+func (m *RegexMatch) GetNamed() map[string]string {
+	if m != nil {
+		return m.Named
+	}
+	return nil
+}
+
+type ExtractResponse struct {
+	Matches              []*RegexMatch `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+	Err                  string        `protobuf:"bytes,2,opt,name=err,proto3" json:"err,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ExtractResponse) Reset()         { *m = ExtractResponse{} }
+func (m *ExtractResponse) String() string { return proto.CompactTextString(m) }
+func (*ExtractResponse) ProtoMessage()    {}
+func (*ExtractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8077f7943c5ff, []int{25}
+}
+
+func (m *ExtractResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExtractResponse.Unmarshal(m, b)
+}
+func (m *ExtractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExtractResponse.Marshal(b, m, deterministic)
+}
+func (m *ExtractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtractResponse.Merge(m, src)
+}
+func (m *ExtractResponse) XXX_Size() int {
+	return xxx_messageInfo_ExtractResponse.Size(m)
+}
+func (m *ExtractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtractResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExtractResponse proto.InternalMessageInfo
+
+func (m *ExtractResponse) GetMatches() []*RegexMatch {
+	if m != nil {
+		return m.Matches
+	}
+	return nil
+}
+
+func (m *ExtractResponse) GetErr() string {
+	if m != nil {
+		return m.Err
+	}
+	return ""
+}
+
+type EncodeRequest struct {
+	S                    string   `protobuf:"bytes,1,opt,name=s,proto3" json:"s,omitempty"`
+	Encoding             string   `protobuf:"bytes,2,opt,name=encoding,proto3" json:"encoding,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EncodeRequest) Reset()         { *m = EncodeRequest{} }
+func (m *EncodeRequest) String() string { return proto.CompactTextString(m) }
+func (*EncodeRequest) ProtoMessage()    {}
+func (*EncodeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8077f7943c5ff, []int{26}
+}
+
+func (m *EncodeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EncodeRequest.Unmarshal(m, b)
+}
+func (m *EncodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EncodeRequest.Marshal(b, m, deterministic)
+}
+func (m *EncodeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EncodeRequest.Merge(m, src)
+}
+func (m *EncodeRequest) XXX_Size() int {
+	return xxx_messageInfo_EncodeRequest.Size(m)
+}
+func (m *EncodeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EncodeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EncodeRequest proto.InternalMessageInfo
+
+func (m *EncodeRequest) GetS() string {
+	if m != nil {
+		return m.S
+	}
+	return ""
+}
+
+func (m *EncodeRequest) GetEncoding() string {
+	if m != nil {
+		return m.Encoding
+	}
+	return ""
+}
+
+type EncodeResponse struct {
+	V                    string   `protobuf:"bytes,1,opt,name=v,proto3" json:"v,omitempty"`
+	Err                  string   `protobuf:"bytes,2,opt,name=err,proto3" json:"err,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EncodeResponse) Reset()         { *m = EncodeResponse{} }
+func (m *EncodeResponse) String() string { return proto.CompactTextString(m) }
+func (*EncodeResponse) ProtoMessage()    {}
+func (*EncodeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8077f7943c5ff, []int{27}
+}
+
+func (m *EncodeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EncodeResponse.Unmarshal(m, b)
+}
+func (m *EncodeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EncodeResponse.Marshal(b, m, deterministic)
+}
+func (m *EncodeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EncodeResponse.Merge(m, src)
+}
+func (m *EncodeResponse) XXX_Size() int {
+	return xxx_messageInfo_EncodeResponse.Size(m)
+}
+func (m *EncodeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EncodeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EncodeResponse proto.InternalMessageInfo
+
+func (m *EncodeResponse) GetV() string {
+	if m != nil {
+		return m.V
+	}
+	return ""
+}
+
+func (m *EncodeResponse) GetErr() string {
+	if m != nil {
+		return m.Err
+	}
+	return ""
+}
+
+type DecodeRequest struct {
+	S                    string   `protobuf:"bytes,1,opt,name=s,proto3" json:"s,omitempty"`
+	Encoding             string   `protobuf:"bytes,2,opt,name=encoding,proto3" json:"encoding,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DecodeRequest) Reset()         { *m = DecodeRequest{} }
+func (m *DecodeRequest) String() string { return proto.CompactTextString(m) }
+func (*DecodeRequest) ProtoMessage()    {}
+func (*DecodeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8077f7943c5ff, []int{28}
+}
+
+func (m *DecodeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DecodeRequest.Unmarshal(m, b)
+}
+func (m *DecodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DecodeRequest.Marshal(b, m, deterministic)
+}
+func (m *DecodeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecodeRequest.Merge(m, src)
+}
+func (m *DecodeRequest) XXX_Size() int {
+	return xxx_messageInfo_DecodeRequest.Size(m)
+}
+func (m *DecodeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecodeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecodeRequest proto.InternalMessageInfo
+
+func (m *DecodeRequest) GetS() string {
+	if m != nil {
+		return m.S
+	}
+	return ""
+}
+
+func (m *DecodeRequest) GetEncoding() string {
+	if m != nil {
+		return m.Encoding
+	}
+	return ""
+}
+
+type DecodeResponse struct {
+	V                    string   `protobuf:"bytes,1,opt,name=v,proto3" json:"v,omitempty"`
+	Err                  string   `protobuf:"bytes,2,opt,name=err,proto3" json:"err,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DecodeResponse) Reset()         { *m = DecodeResponse{} }
+func (m *DecodeResponse) String() string { return proto.CompactTextString(m) }
+func (*DecodeResponse) ProtoMessage()    {}
+func (*DecodeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8077f7943c5ff, []int{29}
+}
+
+func (m *DecodeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DecodeResponse.Unmarshal(m, b)
+}
+func (m *DecodeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DecodeResponse.Marshal(b, m, deterministic)
+}
+func (m *DecodeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecodeResponse.Merge(m, src)
+}
+func (m *DecodeResponse) XXX_Size() int {
+	return xxx_messageInfo_DecodeResponse.Size(m)
+}
+func (m *DecodeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecodeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecodeResponse proto.InternalMessageInfo
+
+func (m *DecodeResponse) GetV() string {
+	if m != nil {
+		return m.V
+	}
+	return ""
+}
+
+func (m *DecodeResponse) GetErr() string {
+	if m != nil {
+		return m.Err
+	}
+	return ""
+}
+
+type HashRequest struct {
+	S                    string   `protobuf:"bytes,1,opt,name=s,proto3" json:"s,omitempty"`
+	Algorithm            string   `protobuf:"bytes,2,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	Output               string   `protobuf:"bytes,3,opt,name=output,proto3" json:"output,omitempty"`
+	Key                  string   `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HashRequest) Reset()         { *m = HashRequest{} }
+func (m *HashRequest) String() string { return proto.CompactTextString(m) }
+func (*HashRequest) ProtoMessage()    {}
+func (*HashRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8077f7943c5ff, []int{30}
+}
+
+func (m *HashRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HashRequest.Unmarshal(m, b)
+}
+func (m *HashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HashRequest.Marshal(b, m, deterministic)
+}
+func (m *HashRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HashRequest.Merge(m, src)
+}
+func (m *HashRequest) XXX_Size() int {
+	return xxx_messageInfo_HashRequest.Size(m)
+}
+func (m *HashRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HashRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HashRequest proto.InternalMessageInfo
+
+func (m *HashRequest) GetS() string {
+	if m != nil {
+		return m.S
+	}
+	return ""
+}
+
+func (m *HashRequest) GetAlgorithm() string {
+	if m != nil {
+		return m.Algorithm
+	}
+	return ""
+}
+
+func (m *HashRequest) GetOutput() string {
+	if m != nil {
+		return m.Output
+	}
+	return ""
+}
+
+func (m *HashRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+type HashResponse struct {
+	V                    string   `protobuf:"bytes,1,opt,name=v,proto3" json:"v,omitempty"`
+	Err                  string   `protobuf:"bytes,2,opt,name=err,proto3" json:"err,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HashResponse) Reset()         { *m = HashResponse{} }
+func (m *HashResponse) String() string { return proto.CompactTextString(m) }
+func (*HashResponse) ProtoMessage()    {}
+func (*HashResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8077f7943c5ff, []int{31}
+}
+
+func (m *HashResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HashResponse.Unmarshal(m, b)
+}
+func (m *HashResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HashResponse.Marshal(b, m, deterministic)
+}
+func (m *HashResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HashResponse.Merge(m, src)
+}
+func (m *HashResponse) XXX_Size() int {
+	return xxx_messageInfo_HashResponse.Size(m)
+}
+func (m *HashResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_HashResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HashResponse proto.InternalMessageInfo
+
+func (m *HashResponse) GetV() string {
+	if m != nil {
+		return m.V
+	}
+	return ""
+}
+
+func (m *HashResponse) GetErr() string {
+	if m != nil {
+		return m.Err
+	}
+	return ""
+}
+
+type CompareRequest struct {
+	A                    string   `protobuf:"bytes,1,opt,name=a,proto3" json:"a,omitempty"`
+	B                    string   `protobuf:"bytes,2,opt,name=b,proto3" json:"b,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CompareRequest) Reset()         { *m = CompareRequest{} }
+func (m *CompareRequest) String() string { return proto.CompactTextString(m) }
+func (*CompareRequest) ProtoMessage()    {}
+func (*CompareRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8077f7943c5ff, []int{32}
+}
+
+func (m *CompareRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CompareRequest.Unmarshal(m, b)
+}
+func (m *CompareRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CompareRequest.Marshal(b, m, deterministic)
+}
+func (m *CompareRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompareRequest.Merge(m, src)
+}
+func (m *CompareRequest) XXX_Size() int {
+	return xxx_messageInfo_CompareRequest.Size(m)
+}
+func (m *CompareRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompareRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompareRequest proto.InternalMessageInfo
+
+func (m *CompareRequest) GetA() string {
+	if m != nil {
+		return m.A
+	}
+	return ""
+}
+
+func (m *CompareRequest) GetB() string {
+	if m != nil {
+		return m.B
+	}
+	return ""
+}
+
+type CompareResponse struct {
+	Levenshtein          int64    `protobuf:"varint,1,opt,name=levenshtein,proto3" json:"levenshtein,omitempty"`
+	DamerauLevenshtein   int64    `protobuf:"varint,2,opt,name=damerau_levenshtein,json=damerauLevenshtein,proto3" json:"damerau_levenshtein,omitempty"`
+	JaroWinkler          float64  `protobuf:"fixed64,3,opt,name=jaro_winkler,json=jaroWinkler,proto3" json:"jaro_winkler,omitempty"`
+	Similarity           float64  `protobuf:"fixed64,4,opt,name=similarity,proto3" json:"similarity,omitempty"`
+	Err                  string   `protobuf:"bytes,5,opt,name=err,proto3" json:"err,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CompareResponse) Reset()         { *m = CompareResponse{} }
+func (m *CompareResponse) String() string { return proto.CompactTextString(m) }
+func (*CompareResponse) ProtoMessage()    {}
+func (*CompareResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8077f7943c5ff, []int{33}
+}
+
+func (m *CompareResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CompareResponse.Unmarshal(m, b)
+}
+func (m *CompareResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CompareResponse.Marshal(b, m, deterministic)
+}
+func (m *CompareResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompareResponse.Merge(m, src)
+}
+func (m *CompareResponse) XXX_Size() int {
+	return xxx_messageInfo_CompareResponse.Size(m)
+}
+func (m *CompareResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompareResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompareResponse proto.InternalMessageInfo
+
+func (m *CompareResponse) GetLevenshtein() int64 {
+	if m != nil {
+		return m.Levenshtein
+	}
+	return 0
+}
+
+func (m *CompareResponse) GetDamerauLevenshtein() int64 {
+	if m != nil {
+		return m.DamerauLevenshtein
+	}
+	return 0
+}
+
+func (m *CompareResponse) GetJaroWinkler() float64 {
+	if m != nil {
+		return m.JaroWinkler
+	}
+	return 0
+}
+
+func (m *CompareResponse) GetSimilarity() float64 {
+	if m != nil {
+		return m.Similarity
+	}
+	return 0
+}
+
+func (m *CompareResponse) GetErr() string {
+	if m != nil {
+		return m.Err
+	}
+	return ""
+}
+
+type DiffRequest struct {
+	A                    string   `protobuf:"bytes,1,opt,name=a,proto3" json:"a,omitempty"`
+	B                    string   `protobuf:"bytes,2,opt,name=b,proto3" json:"b,omitempty"`
+	Mode                 string   `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`
+	Format               string   `protobuf:"bytes,4,opt,name=format,proto3" json:"format,omitempty"`
+	Context              int32    `protobuf:"varint,5,opt,name=context,proto3" json:"context,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DiffRequest) Reset()         { *m = DiffRequest{} }
+func (m *DiffRequest) String() string { return proto.CompactTextString(m) }
+func (*DiffRequest) ProtoMessage()    {}
+func (*DiffRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8077f7943c5ff, []int{34}
+}
+
+func (m *DiffRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DiffRequest.Unmarshal(m, b)
+}
+func (m *DiffRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DiffRequest.Marshal(b, m, deterministic)
+}
+func (m *DiffRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiffRequest.Merge(m, src)
+}
+func (m *DiffRequest) XXX_Size() int {
+	return xxx_messageInfo_DiffRequest.Size(m)
+}
+func (m *DiffRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiffRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiffRequest proto.InternalMessageInfo
+
+func (m *DiffRequest) GetA() string {
+	if m != nil {
+		return m.A
+	}
+	return ""
+}
+
+func (m *DiffRequest) GetB() string {
+	if m != nil {
+		return m.B
+	}
+	return ""
+}
+
+func (m *DiffRequest) GetMode() string {
+	if m != nil {
+		return m.Mode
+	}
+	return ""
+}
+
+func (m *DiffRequest) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+func (m *DiffRequest) GetContext() int32 {
+	if m != nil {
+		return m.Context
+	}
+	return 0
+}
+
+type DiffEdit struct {
+	Op                   string   `protobuf:"bytes,1,opt,name=op,proto3" json:"op,omitempty"`
+	Text                 string   `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DiffEdit) Reset()         { *m = DiffEdit{} }
+func (m *DiffEdit) String() string { return proto.CompactTextString(m) }
+func (*DiffEdit) ProtoMessage()    {}
+func (*DiffEdit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8077f7943c5ff, []int{35}
+}
+
+func (m *DiffEdit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DiffEdit.Unmarshal(m, b)
+}
+func (m *DiffEdit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DiffEdit.Marshal(b, m, deterministic)
+}
+func (m *DiffEdit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiffEdit.Merge(m, src)
+}
+func (m *DiffEdit) XXX_Size() int {
+	return xxx_messageInfo_DiffEdit.Size(m)
+}
+func (m *DiffEdit) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiffEdit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiffEdit proto.InternalMessageInfo
+
+func (m *DiffEdit) GetOp() string {
+	if m != nil {
+		return m.Op
+	}
+	return ""
+}
+
+func (m *DiffEdit) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
+}
+
+type DiffResponse struct {
+	Unified              string      `protobuf:"bytes,1,opt,name=unified,proto3" json:"unified,omitempty"`
+	Edits                []*DiffEdit `protobuf:"bytes,2,rep,name=edits,proto3" json:"edits,omitempty"`
+	Err                  string      `protobuf:"bytes,3,opt,name=err,proto3" json:"err,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *DiffResponse) Reset()         { *m = DiffResponse{} }
+func (m *DiffResponse) String() string { return proto.CompactTextString(m) }
+func (*DiffResponse) ProtoMessage()    {}
+func (*DiffResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8077f7943c5ff, []int{36}
+}
+
+func (m *DiffResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DiffResponse.Unmarshal(m, b)
+}
+func (m *DiffResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DiffResponse.Marshal(b, m, deterministic)
+}
+func (m *DiffResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiffResponse.Merge(m, src)
+}
+func (m *DiffResponse) XXX_Size() int {
+	return xxx_messageInfo_DiffResponse.Size(m)
+}
+func (m *DiffResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiffResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiffResponse proto.InternalMessageInfo
+
+func (m *DiffResponse) GetUnified() string {
+	if m != nil {
+		return m.Unified
+	}
+	return ""
+}
+
+func (m *DiffResponse) GetEdits() []*DiffEdit {
+	if m != nil {
+		return m.Edits
+	}
+	return nil
+}
+
+func (m *DiffResponse) GetErr() string {
+	if m != nil {
+		return m.Err
+	}
+	return ""
+}
+
+type SlugifyRequest struct {
+	S                    string   `protobuf:"bytes,1,opt,name=s,proto3" json:"s,omitempty"`
+	Separator            string   `protobuf:"bytes,2,opt,name=separator,proto3" json:"separator,omitempty"`
+	MaxLength            int32    `protobuf:"varint,3,opt,name=max_length,json=maxLength,proto3" json:"max_length,omitempty"`
+	Existing             []string `protobuf:"bytes,4,rep,name=existing,proto3" json:"existing,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SlugifyRequest) Reset()         { *m = SlugifyRequest{} }
+func (m *SlugifyRequest) String() string { return proto.CompactTextString(m) }
+func (*SlugifyRequest) ProtoMessage()    {}
+func (*SlugifyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8077f7943c5ff, []int{37}
+}
+
+func (m *SlugifyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SlugifyRequest.Unmarshal(m, b)
+}
+func (m *SlugifyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SlugifyRequest.Marshal(b, m, deterministic)
+}
+func (m *SlugifyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlugifyRequest.Merge(m, src)
+}
+func (m *SlugifyRequest) XXX_Size() int {
+	return xxx_messageInfo_SlugifyRequest.Size(m)
+}
+func (m *SlugifyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlugifyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlugifyRequest proto.InternalMessageInfo
+
+func (m *SlugifyRequest) GetS() string {
+	if m != nil {
+		return m.S
+	}
+	return ""
+}
+
+func (m *SlugifyRequest) GetSeparator() string {
+	if m != nil {
+		return m.Separator
+	}
+	return ""
+}
+
+func (m *SlugifyRequest) GetMaxLength() int32 {
+	if m != nil {
+		return m.MaxLength
+	}
+	return 0
+}
+
+func (m *SlugifyRequest) GetExisting() []string {
+	if m != nil {
+		return m.Existing
+	}
+	return nil
+}
+
+type SlugifyResponse struct {
+	V                    string   `protobuf:"bytes,1,opt,name=v,proto3" json:"v,omitempty"`
+	Err                  string   `protobuf:"bytes,2,opt,name=err,proto3" json:"err,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SlugifyResponse) Reset()         { *m = SlugifyResponse{} }
+func (m *SlugifyResponse) String() string { return proto.CompactTextString(m) }
+func (*SlugifyResponse) ProtoMessage()    {}
+func (*SlugifyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8077f7943c5ff, []int{38}
+}
+
+func (m *SlugifyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SlugifyResponse.Unmarshal(m, b)
+}
+func (m *SlugifyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SlugifyResponse.Marshal(b, m, deterministic)
+}
+func (m *SlugifyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlugifyResponse.Merge(m, src)
+}
+func (m *SlugifyResponse) XXX_Size() int {
+	return xxx_messageInfo_SlugifyResponse.Size(m)
+}
+func (m *SlugifyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlugifyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlugifyResponse proto.InternalMessageInfo
+
+func (m *SlugifyResponse) GetV() string {
+	if m != nil {
+		return m.V
+	}
+	return ""
+}
+
+func (m *SlugifyResponse) GetErr() string {
+	if m != nil {
+		return m.Err
+	}
+	return ""
+}
+
+type TokenizeRequest struct {
+	S                    string   `protobuf:"bytes,1,opt,name=s,proto3" json:"s,omitempty"`
+	Unit                 string   `protobuf:"bytes,2,opt,name=unit,proto3" json:"unit,omitempty"`
+	N                    int32    `protobuf:"varint,3,opt,name=n,proto3" json:"n,omitempty"`
+	Lowercase            bool     `protobuf:"varint,4,opt,name=lowercase,proto3" json:"lowercase,omitempty"`
+	StopWords            string   `protobuf:"bytes,5,opt,name=stop_words,json=stopWords,proto3" json:"stop_words,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TokenizeRequest) Reset()         { *m = TokenizeRequest{} }
+func (m *TokenizeRequest) String() string { return proto.CompactTextString(m) }
+func (*TokenizeRequest) ProtoMessage()    {}
+func (*TokenizeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8077f7943c5ff, []int{39}
+}
+
+func (m *TokenizeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenizeRequest.Unmarshal(m, b)
+}
+func (m *TokenizeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenizeRequest.Marshal(b, m, deterministic)
+}
+func (m *TokenizeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenizeRequest.Merge(m, src)
+}
+func (m *TokenizeRequest) XXX_Size() int {
+	return xxx_messageInfo_TokenizeRequest.Size(m)
+}
+func (m *TokenizeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenizeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenizeRequest proto.InternalMessageInfo
+
+func (m *TokenizeRequest) GetS() string {
+	if m != nil {
+		return m.S
+	}
+	return ""
+}
+
+func (m *TokenizeRequest) GetUnit() string {
+	if m != nil {
+		return m.Unit
+	}
+	return ""
+}
+
+func (m *TokenizeRequest) GetN() int32 {
+	if m != nil {
+		return m.N
+	}
+	return 0
+}
+
+func (m *TokenizeRequest) GetLowercase() bool {
+	if m != nil {
+		return m.Lowercase
+	}
+	return false
+}
+
+func (m *TokenizeRequest) GetStopWords() string {
+	if m != nil {
+		return m.StopWords
+	}
+	return ""
+}
+
+type Token struct {
+	Text                 string   `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Start                int64    `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	End                  int64    `protobuf:"varint,3,opt,name=end,proto3" json:"end,omitempty"`
+	RuneStart            int64    `protobuf:"varint,4,opt,name=rune_start,json=runeStart,proto3" json:"rune_start,omitempty"`
+	RuneEnd              int64    `protobuf:"varint,5,opt,name=rune_end,json=runeEnd,proto3" json:"rune_end,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Token) Reset()         { *m = Token{} }
+func (m *Token) String() string { return proto.CompactTextString(m) }
+func (*Token) ProtoMessage()    {}
+func (*Token) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8077f7943c5ff, []int{40}
+}
+
+func (m *Token) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Token.Unmarshal(m, b)
+}
+func (m *Token) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Token.Marshal(b, m, deterministic)
+}
+func (m *Token) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Token.Merge(m, src)
+}
+func (m *Token) XXX_Size() int {
+	return xxx_messageInfo_Token.Size(m)
+}
+func (m *Token) XXX_DiscardUnknown() {
+	xxx_messageInfo_Token.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Token proto.InternalMessageInfo
+
+func (m *Token) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
+}
+
+func (m *Token) GetStart() int64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *Token) GetEnd() int64 {
+	if m != nil {
+		return m.End
+	}
+	return 0
+}
+
+func (m *Token) GetRuneStart() int64 {
+	if m != nil {
+		return m.RuneStart
+	}
+	return 0
+}
+
+func (m *Token) GetRuneEnd() int64 {
+	if m != nil {
+		return m.RuneEnd
+	}
+	return 0
+}
+
+type TokenizeResponse struct {
+	Tokens               []*Token `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	Err                  string   `protobuf:"bytes,2,opt,name=err,proto3" json:"err,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TokenizeResponse) Reset()         { *m = TokenizeResponse{} }
+func (m *TokenizeResponse) String() string { return proto.CompactTextString(m) }
+func (*TokenizeResponse) ProtoMessage()    {}
+func (*TokenizeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8077f7943c5ff, []int{41}
+}
+
+func (m *TokenizeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenizeResponse.Unmarshal(m, b)
+}
+func (m *TokenizeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenizeResponse.Marshal(b, m, deterministic)
+}
+func (m *TokenizeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenizeResponse.Merge(m, src)
+}
+func (m *TokenizeResponse) XXX_Size() int {
+	return xxx_messageInfo_TokenizeResponse.Size(m)
+}
+func (m *TokenizeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenizeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenizeResponse proto.InternalMessageInfo
+
+func (m *TokenizeResponse) GetTokens() []*Token {
+	if m != nil {
+		return m.Tokens
+	}
+	return nil
+}
+
+func (m *TokenizeResponse) GetErr() string {
+	if m != nil {
+		return m.Err
+	}
+	return ""
+}
+
+type RenderRequest struct {
+	Template string `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	// data is a JSON object
+	Data                 string   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RenderRequest) Reset()         { *m = RenderRequest{} }
+func (m *RenderRequest) String() string { return proto.CompactTextString(m) }
+func (*RenderRequest) ProtoMessage()    {}
+func (*RenderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8077f7943c5ff, []int{42}
+}
+
+func (m *RenderRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RenderRequest.Unmarshal(m, b)
+}
+func (m *RenderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RenderRequest.Marshal(b, m, deterministic)
+}
+func (m *RenderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RenderRequest.Merge(m, src)
+}
+func (m *RenderRequest) XXX_Size() int {
+	return xxx_messageInfo_RenderRequest.Size(m)
+}
+func (m *RenderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RenderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RenderRequest proto.InternalMessageInfo
+
+func (m *RenderRequest) GetTemplate() string {
+	if m != nil {
+		return m.Template
+	}
+	return ""
+}
+
+func (m *RenderRequest) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+type RenderResponse struct {
+	V                    string   `protobuf:"bytes,1,opt,name=v,proto3" json:"v,omitempty"`
+	Err                  string   `protobuf:"bytes,2,opt,name=err,proto3" json:"err,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RenderResponse) Reset()         { *m = RenderResponse{} }
+func (m *RenderResponse) String() string { return proto.CompactTextString(m) }
+func (*RenderResponse) ProtoMessage()    {}
+func (*RenderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8077f7943c5ff, []int{43}
+}
+
+func (m *RenderResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RenderResponse.Unmarshal(m, b)
+}
+func (m *RenderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RenderResponse.Marshal(b, m, deterministic)
+}
+func (m *RenderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RenderResponse.Merge(m, src)
+}
+func (m *RenderResponse) XXX_Size() int {
+	return xxx_messageInfo_RenderResponse.Size(m)
+}
+func (m *RenderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RenderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RenderResponse proto.InternalMessageInfo
+
+func (m *RenderResponse) GetV() string {
+	if m != nil {
+		return m.V
+	}
+	return ""
+}
+
+func (m *RenderResponse) GetErr() string {
+	if m != nil {
+		return m.Err
+	}
+	return ""
+}
+
+type RedactRequest struct {
+	S                    string   `protobuf:"bytes,1,opt,name=s,proto3" json:"s,omitempty"`
+	Detectors            []string `protobuf:"bytes,2,rep,name=detectors,proto3" json:"detectors,omitempty"`
+	Style                string   `protobuf:"bytes,3,opt,name=style,proto3" json:"style,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RedactRequest) Reset()         { *m = RedactRequest{} }
+func (m *RedactRequest) String() string { return proto.CompactTextString(m) }
+func (*RedactRequest) ProtoMessage()    {}
+func (*RedactRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8077f7943c5ff, []int{44}
+}
+
+func (m *RedactRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RedactRequest.Unmarshal(m, b)
+}
+func (m *RedactRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RedactRequest.Marshal(b, m, deterministic)
+}
+func (m *RedactRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedactRequest.Merge(m, src)
+}
+func (m *RedactRequest) XXX_Size() int {
+	return xxx_messageInfo_RedactRequest.Size(m)
+}
+func (m *RedactRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedactRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedactRequest proto.InternalMessageInfo
+
+func (m *RedactRequest) GetS() string {
+	if m != nil {
+		return m.S
+	}
+	return ""
+}
+
+func (m *RedactRequest) GetDetectors() []string {
+	if m != nil {
+		return m.Detectors
+	}
+	return nil
+}
+
+func (m *RedactRequest) GetStyle() string {
+	if m != nil {
+		return m.Style
+	}
+	return ""
+}
+
+type Finding struct {
+	Type                 string   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Start                int64    `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	End                  int64    `protobuf:"varint,3,opt,name=end,proto3" json:"end,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Finding) Reset()         { *m = Finding{} }
+func (m *Finding) String() string { return proto.CompactTextString(m) }
+func (*Finding) ProtoMessage()    {}
+func (*Finding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8077f7943c5ff, []int{45}
+}
+
+func (m *Finding) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Finding.Unmarshal(m, b)
+}
+func (m *Finding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Finding.Marshal(b, m, deterministic)
+}
+func (m *Finding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Finding.Merge(m, src)
+}
+func (m *Finding) XXX_Size() int {
+	return xxx_messageInfo_Finding.Size(m)
+}
+func (m *Finding) XXX_DiscardUnknown() {
+	xxx_messageInfo_Finding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Finding proto.InternalMessageInfo
+
+func (m *Finding) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *Finding) GetStart() int64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *Finding) GetEnd() int64 {
+	if m != nil {
+		return m.End
+	}
+	return 0
+}
+
+type RedactResponse struct {
+	V                    string     `protobuf:"bytes,1,opt,name=v,proto3" json:"v,omitempty"`
+	Findings             []*Finding `protobuf:"bytes,2,rep,name=findings,proto3" json:"findings,omitempty"`
+	Err                  string     `protobuf:"bytes,3,opt,name=err,proto3" json:"err,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *RedactResponse) Reset()         { *m = RedactResponse{} }
+func (m *RedactResponse) String() string { return proto.CompactTextString(m) }
+func (*RedactResponse) ProtoMessage()    {}
+func (*RedactResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8077f7943c5ff, []int{46}
+}
+
+func (m *RedactResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RedactResponse.Unmarshal(m, b)
+}
+func (m *RedactResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RedactResponse.Marshal(b, m, deterministic)
+}
+func (m *RedactResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedactResponse.Merge(m, src)
+}
+func (m *RedactResponse) XXX_Size() int {
+	return xxx_messageInfo_RedactResponse.Size(m)
+}
+func (m *RedactResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedactResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedactResponse proto.InternalMessageInfo
+
+func (m *RedactResponse) GetV() string {
+	if m != nil {
+		return m.V
+	}
+	return ""
+}
+
+func (m *RedactResponse) GetFindings() []*Finding {
+	if m != nil {
+		return m.Findings
+	}
+	return nil
+}
+
+func (m *RedactResponse) GetErr() string {
+	if m != nil {
+		return m.Err
+	}
+	return ""
+}
+
+type DetectLanguageRequest struct {
+	S                    string   `protobuf:"bytes,1,opt,name=s,proto3" json:"s,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DetectLanguageRequest) Reset()         { *m = DetectLanguageRequest{} }
+func (m *DetectLanguageRequest) String() string { return proto.CompactTextString(m) }
+func (*DetectLanguageRequest) ProtoMessage()    {}
+func (*DetectLanguageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8077f7943c5ff, []int{47}
+}
+
+func (m *DetectLanguageRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DetectLanguageRequest.Unmarshal(m, b)
+}
+func (m *DetectLanguageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DetectLanguageRequest.Marshal(b, m, deterministic)
+}
+func (m *DetectLanguageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DetectLanguageRequest.Merge(m, src)
+}
+func (m *DetectLanguageRequest) XXX_Size() int {
+	return xxx_messageInfo_DetectLanguageRequest.Size(m)
+}
+func (m *DetectLanguageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DetectLanguageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DetectLanguageRequest proto.InternalMessageInfo
+
+func (m *DetectLanguageRequest) GetS() string {
+	if m != nil {
+		return m.S
+	}
+	return ""
+}
+
+type LanguageScore struct {
+	Language             string   `protobuf:"bytes,1,opt,name=language,proto3" json:"language,omitempty"`
+	Confidence           float64  `protobuf:"fixed64,2,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LanguageScore) Reset()         { *m = LanguageScore{} }
+func (m *LanguageScore) String() string { return proto.CompactTextString(m) }
+func (*LanguageScore) ProtoMessage()    {}
+func (*LanguageScore) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8077f7943c5ff, []int{48}
+}
+
+func (m *LanguageScore) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LanguageScore.Unmarshal(m, b)
+}
+func (m *LanguageScore) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LanguageScore.Marshal(b, m, deterministic)
+}
+func (m *LanguageScore) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LanguageScore.Merge(m, src)
+}
+func (m *LanguageScore) XXX_Size() int {
+	return xxx_messageInfo_LanguageScore.Size(m)
+}
+func (m *LanguageScore) XXX_DiscardUnknown() {
+	xxx_messageInfo_LanguageScore.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LanguageScore proto.InternalMessageInfo
+
+func (m *LanguageScore) GetLanguage() string {
+	if m != nil {
+		return m.Language
+	}
+	return ""
+}
+
+func (m *LanguageScore) GetConfidence() float64 {
+	if m != nil {
+		return m.Confidence
+	}
+	return 0
+}
+
+type DetectLanguageResponse struct {
+	Script               string           `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
+	Languages            []*LanguageScore `protobuf:"bytes,2,rep,name=languages,proto3" json:"languages,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *DetectLanguageResponse) Reset()         { *m = DetectLanguageResponse{} }
+func (m *DetectLanguageResponse) String() string { return proto.CompactTextString(m) }
+func (*DetectLanguageResponse) ProtoMessage()    {}
+func (*DetectLanguageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8077f7943c5ff, []int{49}
+}
+
+func (m *DetectLanguageResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DetectLanguageResponse.Unmarshal(m, b)
+}
+func (m *DetectLanguageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DetectLanguageResponse.Marshal(b, m, deterministic)
+}
+func (m *DetectLanguageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DetectLanguageResponse.Merge(m, src)
+}
+func (m *DetectLanguageResponse) XXX_Size() int {
+	return xxx_messageInfo_DetectLanguageResponse.Size(m)
+}
+func (m *DetectLanguageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DetectLanguageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DetectLanguageResponse proto.InternalMessageInfo
+
+func (m *DetectLanguageResponse) GetScript() string {
+	if m != nil {
+		return m.Script
+	}
+	return ""
+}
+
+func (m *DetectLanguageResponse) GetLanguages() []*LanguageScore {
+	if m != nil {
+		return m.Languages
+	}
+	return nil
+}
+
+type TruncateRequest struct {
+	S                    string   `protobuf:"bytes,1,opt,name=s,proto3" json:"s,omitempty"`
+	Unit                 string   `protobuf:"bytes,2,opt,name=unit,proto3" json:"unit,omitempty"`
+	Max                  int32    `protobuf:"varint,3,opt,name=max,proto3" json:"max,omitempty"`
+	Ellipsis             string   `protobuf:"bytes,4,opt,name=ellipsis,proto3" json:"ellipsis,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TruncateRequest) Reset()         { *m = TruncateRequest{} }
+func (m *TruncateRequest) String() string { return proto.CompactTextString(m) }
+func (*TruncateRequest) ProtoMessage()    {}
+func (*TruncateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8077f7943c5ff, []int{50}
+}
+
+func (m *TruncateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TruncateRequest.Unmarshal(m, b)
+}
+func (m *TruncateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TruncateRequest.Marshal(b, m, deterministic)
+}
+func (m *TruncateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TruncateRequest.Merge(m, src)
+}
+func (m *TruncateRequest) XXX_Size() int {
+	return xxx_messageInfo_TruncateRequest.Size(m)
+}
+func (m *TruncateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TruncateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TruncateRequest proto.InternalMessageInfo
+
+func (m *TruncateRequest) GetS() string {
+	if m != nil {
+		return m.S
+	}
+	return ""
+}
+
+func (m *TruncateRequest) GetUnit() string {
+	if m != nil {
+		return m.Unit
+	}
+	return ""
+}
+
+func (m *TruncateRequest) GetMax() int32 {
+	if m != nil {
+		return m.Max
+	}
+	return 0
+}
+
+func (m *TruncateRequest) GetEllipsis() string {
+	if m != nil {
+		return m.Ellipsis
+	}
+	return ""
+}
+
+type TruncateResponse struct {
+	V                    string   `protobuf:"bytes,1,opt,name=v,proto3" json:"v,omitempty"`
+	Err                  string   `protobuf:"bytes,2,opt,name=err,proto3" json:"err,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TruncateResponse) Reset()         { *m = TruncateResponse{} }
+func (m *TruncateResponse) String() string { return proto.CompactTextString(m) }
+func (*TruncateResponse) ProtoMessage()    {}
+func (*TruncateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8077f7943c5ff, []int{51}
+}
+
+func (m *TruncateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TruncateResponse.Unmarshal(m, b)
+}
+func (m *TruncateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TruncateResponse.Marshal(b, m, deterministic)
+}
+func (m *TruncateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TruncateResponse.Merge(m, src)
+}
+func (m *TruncateResponse) XXX_Size() int {
+	return xxx_messageInfo_TruncateResponse.Size(m)
+}
+func (m *TruncateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TruncateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TruncateResponse proto.InternalMessageInfo
+
+func (m *TruncateResponse) GetV() string {
+	if m != nil {
+		return m.V
+	}
+	return ""
+}
+
+func (m *TruncateResponse) GetErr() string {
+	if m != nil {
+		return m.Err
+	}
+	return ""
+}
+
+type WrapRequest struct {
+	S                    string   `protobuf:"bytes,1,opt,name=s,proto3" json:"s,omitempty"`
+	Width                int32    `protobuf:"varint,2,opt,name=width,proto3" json:"width,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WrapRequest) Reset()         { *m = WrapRequest{} }
+func (m *WrapRequest) String() string { return proto.CompactTextString(m) }
+func (*WrapRequest) ProtoMessage()    {}
+func (*WrapRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8077f7943c5ff, []int{52}
+}
+
+func (m *WrapRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WrapRequest.Unmarshal(m, b)
+}
+func (m *WrapRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WrapRequest.Marshal(b, m, deterministic)
+}
+func (m *WrapRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WrapRequest.Merge(m, src)
+}
+func (m *WrapRequest) XXX_Size() int {
+	return xxx_messageInfo_WrapRequest.Size(m)
+}
+func (m *WrapRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WrapRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WrapRequest proto.InternalMessageInfo
+
+func (m *WrapRequest) GetS() string {
+	if m != nil {
+		return m.S
+	}
+	return ""
+}
+
+func (m *WrapRequest) GetWidth() int32 {
+	if m != nil {
+		return m.Width
+	}
+	return 0
+}
+
+type WrapResponse struct {
+	V                    string   `protobuf:"bytes,1,opt,name=v,proto3" json:"v,omitempty"`
+	Err                  string   `protobuf:"bytes,2,opt,name=err,proto3" json:"err,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WrapResponse) Reset()         { *m = WrapResponse{} }
+func (m *WrapResponse) String() string { return proto.CompactTextString(m) }
+func (*WrapResponse) ProtoMessage()    {}
+func (*WrapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8077f7943c5ff, []int{53}
+}
+
+func (m *WrapResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WrapResponse.Unmarshal(m, b)
+}
+func (m *WrapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WrapResponse.Marshal(b, m, deterministic)
+}
+func (m *WrapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WrapResponse.Merge(m, src)
+}
+func (m *WrapResponse) XXX_Size() int {
+	return xxx_messageInfo_WrapResponse.Size(m)
+}
+func (m *WrapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WrapResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WrapResponse proto.InternalMessageInfo
+
+func (m *WrapResponse) GetV() string {
+	if m != nil {
+		return m.V
+	}
+	return ""
+}
+
+func (m *WrapResponse) GetErr() string {
+	if m != nil {
+		return m.Err
+	}
+	return ""
+}
+
+type SubmitJobRequest struct {
+	Batch                *BatchRequest    `protobuf:"bytes,1,opt,name=batch,proto3" json:"batch,omitempty"`
+	Pipeline             *PipelineRequest `protobuf:"bytes,2,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	CallbackUrl          string           `protobuf:"bytes,3,opt,name=callback_url,json=callbackUrl,proto3" json:"callback_url,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *SubmitJobRequest) Reset()         { *m = SubmitJobRequest{} }
+func (m *SubmitJobRequest) String() string { return proto.CompactTextString(m) }
+func (*SubmitJobRequest) ProtoMessage()    {}
+func (*SubmitJobRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8077f7943c5ff, []int{54}
+}
+
+func (m *SubmitJobRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubmitJobRequest.Unmarshal(m, b)
+}
+func (m *SubmitJobRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubmitJobRequest.Marshal(b, m, deterministic)
+}
+func (m *SubmitJobRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubmitJobRequest.Merge(m, src)
+}
+func (m *SubmitJobRequest) XXX_Size() int {
+	return xxx_messageInfo_SubmitJobRequest.Size(m)
+}
+func (m *SubmitJobRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubmitJobRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubmitJobRequest proto.InternalMessageInfo
+
+func (m *SubmitJobRequest) GetBatch() *BatchRequest {
+	if m != nil {
+		return m.Batch
+	}
+	return nil
+}
+
+func (m *SubmitJobRequest) GetPipeline() *PipelineRequest {
+	if m != nil {
+		return m.Pipeline
+	}
+	return nil
+}
+
+func (m *SubmitJobRequest) GetCallbackUrl() string {
+	if m != nil {
+		return m.CallbackUrl
+	}
+	return ""
+}
+
+type JobRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *JobRequest) Reset()         { *m = JobRequest{} }
+func (m *JobRequest) String() string { return proto.CompactTextString(m) }
+func (*JobRequest) ProtoMessage()    {}
+func (*JobRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8077f7943c5ff, []int{55}
+}
+
+func (m *JobRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JobRequest.Unmarshal(m, b)
+}
+func (m *JobRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JobRequest.Marshal(b, m, deterministic)
+}
+func (m *JobRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobRequest.Merge(m, src)
+}
+func (m *JobRequest) XXX_Size() int {
+	return xxx_messageInfo_JobRequest.Size(m)
+}
+func (m *JobRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobRequest proto.InternalMessageInfo
+
+func (m *JobRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type JobResponse struct {
+	Id                   string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status               string            `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Done                 int64             `protobuf:"varint,3,opt,name=done,proto3" json:"done,omitempty"`
+	Total                int64             `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	Results              []*BatchResult    `protobuf:"bytes,5,rep,name=results,proto3" json:"results,omitempty"`
+	Pipeline             *PipelineResponse `protobuf:"bytes,6,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	Reason               string            `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt            string            `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt            string            `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Err                  string            `protobuf:"bytes,10,opt,name=err,proto3" json:"err,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *JobResponse) Reset()         { *m = JobResponse{} }
+func (m *JobResponse) String() string { return proto.CompactTextString(m) }
+func (*JobResponse) ProtoMessage()    {}
+func (*JobResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8077f7943c5ff, []int{56}
+}
+
+func (m *JobResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JobResponse.Unmarshal(m, b)
+}
+func (m *JobResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JobResponse.Marshal(b, m, deterministic)
+}
+func (m *JobResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobResponse.Merge(m, src)
+}
+func (m *JobResponse) XXX_Size() int {
+	return xxx_messageInfo_JobResponse.Size(m)
+}
+func (m *JobResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobResponse proto.InternalMessageInfo
+
+func (m *JobResponse) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *JobResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *JobResponse) GetDone() int64 {
+	if m != nil {
+		return m.Done
+	}
+	return 0
+}
+
+func (m *JobResponse) GetTotal() int64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *JobResponse) GetResults() []*BatchResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *JobResponse) GetPipeline() *PipelineResponse {
+	if m != nil {
+		return m.Pipeline
+	}
+	return nil
+}
+
+func (m *JobResponse) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *JobResponse) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
+
+func (m *JobResponse) GetUpdatedAt() string {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return ""
+}
+
+func (m *JobResponse) GetErr() string {
+	if m != nil {
+		return m.Err
+	}
+	return ""
+}
+
+type DeliveryAttempt struct {
+	Attempt              int64    `protobuf:"varint,1,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	Time                 string   `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	StatusCode           int64    `protobuf:"varint,3,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	Error                string   `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	DurationMs           int64    `protobuf:"varint,5,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeliveryAttempt) Reset()         { *m = DeliveryAttempt{} }
+func (m *DeliveryAttempt) String() string { return proto.CompactTextString(m) }
+func (*DeliveryAttempt) ProtoMessage()    {}
+func (*DeliveryAttempt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8077f7943c5ff, []int{57}
+}
+
+func (m *DeliveryAttempt) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeliveryAttempt.Unmarshal(m, b)
+}
+func (m *DeliveryAttempt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeliveryAttempt.Marshal(b, m, deterministic)
+}
+func (m *DeliveryAttempt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeliveryAttempt.Merge(m, src)
+}
+func (m *DeliveryAttempt) XXX_Size() int {
+	return xxx_messageInfo_DeliveryAttempt.Size(m)
+}
+func (m *DeliveryAttempt) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeliveryAttempt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeliveryAttempt proto.InternalMessageInfo
+
+func (m *DeliveryAttempt) GetAttempt() int64 {
+	if m != nil {
+		return m.Attempt
+	}
+	return 0
+}
+
+func (m *DeliveryAttempt) GetTime() string {
+	if m != nil {
+		return m.Time
+	}
+	return ""
+}
+
+func (m *DeliveryAttempt) GetStatusCode() int64 {
+	if m != nil {
+		return m.StatusCode
+	}
+	return 0
+}
+
+func (m *DeliveryAttempt) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *DeliveryAttempt) GetDurationMs() int64 {
+	if m != nil {
+		return m.DurationMs
+	}
+	return 0
+}
+
+type JobDeliveriesResponse struct {
+	Deliveries           []*DeliveryAttempt `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	Err                  string             `protobuf:"bytes,2,opt,name=err,proto3" json:"err,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *JobDeliveriesResponse) Reset()         { *m = JobDeliveriesResponse{} }
+func (m *JobDeliveriesResponse) String() string { return proto.CompactTextString(m) }
+func (*JobDeliveriesResponse) ProtoMessage()    {}
+func (*JobDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8077f7943c5ff, []int{58}
+}
+
+func (m *JobDeliveriesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JobDeliveriesResponse.Unmarshal(m, b)
+}
+func (m *JobDeliveriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JobDeliveriesResponse.Marshal(b, m, deterministic)
+}
+func (m *JobDeliveriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobDeliveriesResponse.Merge(m, src)
+}
+func (m *JobDeliveriesResponse) XXX_Size() int {
+	return xxx_messageInfo_JobDeliveriesResponse.Size(m)
+}
+func (m *JobDeliveriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobDeliveriesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobDeliveriesResponse proto.InternalMessageInfo
+
+func (m *JobDeliveriesResponse) GetDeliveries() []*DeliveryAttempt {
+	if m != nil {
+		return m.Deliveries
+	}
+	return nil
+}
+
+func (m *JobDeliveriesResponse) GetErr() string {
+	if m != nil {
+		return m.Err
+	}
+	return ""
+}
+
+type AuthRequest struct {
+	Username             string   `protobuf:"bytes,1,opt,name=Username,proto3" json:"Username,omitempty"`
+	Password             string   `protobuf:"bytes,2,opt,name=Password,proto3" json:"Password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuthRequest) Reset()         { *m = AuthRequest{} }
+func (m *AuthRequest) String() string { return proto.CompactTextString(m) }
+func (*AuthRequest) ProtoMessage()    {}
+func (*AuthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8077f7943c5ff, []int{59}
+}
+
+func (m *AuthRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuthRequest.Unmarshal(m, b)
+}
+func (m *AuthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuthRequest.Marshal(b, m, deterministic)
+}
+func (m *AuthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthRequest.Merge(m, src)
+}
+func (m *AuthRequest) XXX_Size() int {
+	return xxx_messageInfo_AuthRequest.Size(m)
+}
+func (m *AuthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthRequest proto.InternalMessageInfo
+
+func (m *AuthRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *AuthRequest) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+type AuthResponse struct {
+	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Err                  string   `protobuf:"bytes,2,opt,name=err,proto3" json:"err,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuthResponse) Reset()         { *m = AuthResponse{} }
+func (m *AuthResponse) String() string { return proto.CompactTextString(m) }
+func (*AuthResponse) ProtoMessage()    {}
+func (*AuthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02f8077f7943c5ff, []int{60}
+}
+
+func (m *AuthResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuthResponse.Unmarshal(m, b)
+}
+func (m *AuthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuthResponse.Marshal(b, m, deterministic)
+}
+func (m *AuthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthResponse.Merge(m, src)
+}
+func (m *AuthResponse) XXX_Size() int {
+	return xxx_messageInfo_AuthResponse.Size(m)
+}
+func (m *AuthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthResponse proto.InternalMessageInfo
+
+func (m *AuthResponse) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *AuthResponse) GetErr() string {
+	if m != nil {
+		return m.Err
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*UppercaseRequest)(nil), "pb.UppercaseRequest")
+	proto.RegisterType((*UppercaseResponse)(nil), "pb.UppercaseResponse")
+	proto.RegisterType((*LowercaseRequest)(nil), "pb.LowercaseRequest")
+	proto.RegisterType((*LowercaseResponse)(nil), "pb.LowercaseResponse")
+	proto.RegisterType((*TitleCaseRequest)(nil), "pb.TitleCaseRequest")
+	proto.RegisterType((*TitleCaseResponse)(nil), "pb.TitleCaseResponse")
+	proto.RegisterType((*CountRequest)(nil), "pb.CountRequest")
+	proto.RegisterType((*CountResponse)(nil), "pb.CountResponse")
+	proto.RegisterType((*NormalizeRequest)(nil), "pb.NormalizeRequest")
+	proto.RegisterType((*NormalizeResponse)(nil), "pb.NormalizeResponse")
+	proto.RegisterType((*AnalyzeRequest)(nil), "pb.AnalyzeRequest")
+	proto.RegisterType((*AnalyzeResponse)(nil), "pb.AnalyzeResponse")
+	proto.RegisterMapType((map[string]int64)(nil), "pb.AnalyzeResponse.FrequencyEntry")
+	proto.RegisterType((*BatchItem)(nil), "pb.BatchItem")
+	proto.RegisterType((*BatchResult)(nil), "pb.BatchResult")
+	proto.RegisterType((*BatchRequest)(nil), "pb.BatchRequest")
+	proto.RegisterType((*BatchResponse)(nil), "pb.BatchResponse")
+	proto.RegisterType((*TransformRequest)(nil), "pb.TransformRequest")
+	proto.RegisterType((*TransformResponse)(nil), "pb.TransformResponse")
+	proto.RegisterType((*PipelineStep)(nil), "pb.PipelineStep")
+	proto.RegisterMapType((map[string]string)(nil), "pb.PipelineStep.ParamsEntry")
+	proto.RegisterType((*PipelineRequest)(nil), "pb.PipelineRequest")
+	proto.RegisterType((*PipelineResponse)(nil), "pb.PipelineResponse")
+	proto.RegisterType((*ReplaceRequest)(nil), "pb.ReplaceRequest")
+	proto.RegisterType((*ReplaceResponse)(nil), "pb.ReplaceResponse")
+	proto.RegisterType((*ExtractRequest)(nil), "pb.ExtractRequest")
+	proto.RegisterType((*RegexMatch)(nil), "pb.RegexMatch")
+	proto.RegisterMapType((map[string]string)(nil), "pb.RegexMatch.NamedEntry")
+	proto.RegisterType((*ExtractResponse)(nil), "pb.ExtractResponse")
+	proto.RegisterType((*EncodeRequest)(nil), "pb.EncodeRequest")
+	proto.RegisterType((*EncodeResponse)(nil), "pb.EncodeResponse")
+	proto.RegisterType((*DecodeRequest)(nil), "pb.DecodeRequest")
+	proto.RegisterType((*DecodeResponse)(nil), "pb.DecodeResponse")
+	proto.RegisterType((*HashRequest)(nil), "pb.HashRequest")
+	proto.RegisterType((*HashResponse)(nil), "pb.HashResponse")
+	proto.RegisterType((*CompareRequest)(nil), "pb.CompareRequest")
+	proto.RegisterType((*CompareResponse)(nil), "pb.CompareResponse")
+	proto.RegisterType((*DiffRequest)(nil), "pb.DiffRequest")
+	proto.RegisterType((*DiffEdit)(nil), "pb.DiffEdit")
+	proto.RegisterType((*DiffResponse)(nil), "pb.DiffResponse")
+	proto.RegisterType((*SlugifyRequest)(nil), "pb.SlugifyRequest")
+	proto.RegisterType((*SlugifyResponse)(nil), "pb.SlugifyResponse")
+	proto.RegisterType((*TokenizeRequest)(nil), "pb.TokenizeRequest")
+	proto.RegisterType((*Token)(nil), "pb.Token")
+	proto.RegisterType((*TokenizeResponse)(nil), "pb.TokenizeResponse")
+	proto.RegisterType((*RenderRequest)(nil), "pb.RenderRequest")
+	proto.RegisterType((*RenderResponse)(nil), "pb.RenderResponse")
+	proto.RegisterType((*RedactRequest)(nil), "pb.RedactRequest")
+	proto.RegisterType((*Finding)(nil), "pb.Finding")
+	proto.RegisterType((*RedactResponse)(nil), "pb.RedactResponse")
+	proto.RegisterType((*DetectLanguageRequest)(nil), "pb.DetectLanguageRequest")
+	proto.RegisterType((*LanguageScore)(nil), "pb.LanguageScore")
+	proto.RegisterType((*DetectLanguageResponse)(nil), "pb.DetectLanguageResponse")
+	proto.RegisterType((*TruncateRequest)(nil), "pb.TruncateRequest")
+	proto.RegisterType((*TruncateResponse)(nil), "pb.TruncateResponse")
+	proto.RegisterType((*WrapRequest)(nil), "pb.WrapRequest")
+	proto.RegisterType((*WrapResponse)(nil), "pb.WrapResponse")
+	proto.RegisterType((*SubmitJobRequest)(nil), "pb.SubmitJobRequest")
+	proto.RegisterType((*JobRequest)(nil), "pb.JobRequest")
+	proto.RegisterType((*JobResponse)(nil), "pb.JobResponse")
+	proto.RegisterType((*DeliveryAttempt)(nil), "pb.DeliveryAttempt")
+	proto.RegisterType((*JobDeliveriesResponse)(nil), "pb.JobDeliveriesResponse")
+	proto.RegisterType((*AuthRequest)(nil), "pb.AuthRequest")
+	proto.RegisterType((*AuthResponse)(nil), "pb.AuthResponse")
+}
+
+func init() { proto.RegisterFile("stringsvc.proto", fileDescriptor_02f8077f7943c5ff) }
+
+var fileDescriptor_02f8077f7943c5ff = []byte{
+	// 2262 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x59, 0xdd, 0x72, 0xdc, 0x48,
+	0x15, 0xde, 0x99, 0xc9, 0xd8, 0xa3, 0x33, 0xe3, 0xf1, 0x44, 0x8e, 0x53, 0x13, 0x91, 0x5d, 0xbc,
+	0xa2, 0x58, 0xbc, 0x05, 0xe5, 0xfc, 0x6d, 0xb1, 0x49, 0xa0, 0x58, 0x4c, 0xec, 0x2c, 0xbb, 0x24,
+	0x5b, 0x41, 0x4e, 0x48, 0x71, 0xb3, 0x53, 0x3d, 0x52, 0x7b, 0xdc, 0x44, 0x7f, 0xb4, 0x5a, 0xfe,
+	0xa1, 0x80, 0x47, 0x80, 0x4b, 0x5e, 0x80, 0x97, 0xe0, 0x19, 0x78, 0x20, 0x2e, 0xb8, 0xa1, 0x4e,
+	0xff, 0x48, 0x2d, 0x79, 0x9c, 0x9d, 0x84, 0x3b, 0x9d, 0xaf, 0xfb, 0xeb, 0xd3, 0xe7, 0x9c, 0xee,
+	0xd3, 0xa7, 0x5b, 0xb0, 0x59, 0x08, 0xce, 0xd2, 0x45, 0x71, 0x1a, 0xee, 0xe5, 0x3c, 0x13, 0x99,
+	0xdb, 0xcd, 0xe7, 0xfe, 0x43, 0x98, 0xbc, 0xca, 0x73, 0xca, 0x43, 0x52, 0xd0, 0x80, 0xfe, 0xb1,
+	0xa4, 0x85, 0x70, 0x47, 0xd0, 0x29, 0xa6, 0x9d, 0x9d, 0xce, 0xae, 0x13, 0x74, 0x0a, 0xf7, 0x26,
+	0xac, 0xc5, 0x59, 0x48, 0x62, 0x3a, 0xed, 0x4a, 0x48, 0x4b, 0xfe, 0x03, 0xb8, 0x6e, 0x31, 0x8b,
+	0x3c, 0x4b, 0x0b, 0x8a, 0xd4, 0x53, 0x43, 0x3d, 0x75, 0x27, 0xd0, 0xa3, 0x9c, 0x6b, 0x1e, 0x7e,
+	0xa2, 0xba, 0x67, 0xd9, 0xd9, 0x7b, 0xaa, 0xb3, 0x98, 0xab, 0xab, 0x7b, 0xc9, 0x44, 0x4c, 0x9f,
+	0xbc, 0x8f, 0x3a, 0x8b, 0xb9, 0xa2, 0xba, 0xbb, 0x30, 0x7a, 0x92, 0x95, 0xa9, 0x58, 0xae, 0xca,
+	0x85, 0x6b, 0x65, 0xca, 0x84, 0x26, 0xc8, 0x6f, 0xff, 0x0e, 0x6c, 0x68, 0x46, 0x5b, 0x45, 0x6f,
+	0xb9, 0x8a, 0x3f, 0xc3, 0xe4, 0x9b, 0x8c, 0x27, 0x24, 0x66, 0x7f, 0xa2, 0x57, 0xaa, 0x39, 0xce,
+	0x78, 0x62, 0xd4, 0xe0, 0xb7, 0xfb, 0x3d, 0x70, 0xd0, 0x6f, 0xb3, 0xe3, 0x2c, 0x8e, 0xa6, 0xbd,
+	0x9d, 0xce, 0xee, 0x20, 0x18, 0x20, 0xf0, 0x34, 0x8b, 0x23, 0xf7, 0x53, 0x98, 0xe0, 0xca, 0xc8,
+	0x67, 0x11, 0x23, 0x21, 0x67, 0x82, 0x85, 0xc5, 0xf4, 0x9a, 0xec, 0x23, 0x57, 0x4c, 0x7e, 0x50,
+	0xc1, 0xe8, 0x15, 0x4b, 0xfb, 0x8a, 0x5e, 0xf9, 0x08, 0xc6, 0xfb, 0x29, 0x89, 0x2f, 0xae, 0x98,
+	0xb0, 0xff, 0xdf, 0x2e, 0x6c, 0x56, 0x1d, 0xf4, 0x98, 0x37, 0xa0, 0x3f, 0xbf, 0x10, 0xb4, 0xd0,
+	0xae, 0x50, 0x02, 0xa2, 0xbc, 0x4c, 0x69, 0x21, 0x47, 0xef, 0x05, 0x4a, 0x70, 0x6f, 0x83, 0xb3,
+	0xe0, 0x24, 0x3f, 0xa1, 0x09, 0x2d, 0xa4, 0x71, 0xbd, 0xa0, 0x06, 0x90, 0x73, 0x96, 0xf1, 0x48,
+	0x99, 0xd4, 0x0b, 0x94, 0x80, 0x9c, 0x82, 0xa6, 0x82, 0xa6, 0x21, 0x2d, 0xa6, 0x7d, 0xc5, 0xa9,
+	0x00, 0xe4, 0xc4, 0x0c, 0xf5, 0xac, 0x29, 0x8e, 0x14, 0xdc, 0x8f, 0x00, 0xce, 0x4e, 0x98, 0xa0,
+	0x45, 0x4e, 0x42, 0x3a, 0x5d, 0x97, 0x4d, 0x16, 0xe2, 0x7a, 0x30, 0x88, 0x58, 0x21, 0x58, 0x1a,
+	0x8a, 0xe9, 0x40, 0xb6, 0x56, 0xb2, 0x3b, 0x85, 0xf5, 0x22, 0xe4, 0x2c, 0x17, 0xc5, 0xd4, 0xd9,
+	0xe9, 0xed, 0x3a, 0x81, 0x11, 0xdd, 0x5f, 0x82, 0x73, 0xcc, 0xd1, 0x2f, 0x69, 0x78, 0x31, 0x85,
+	0x9d, 0xde, 0xee, 0xf0, 0xbe, 0xbf, 0x97, 0xcf, 0xf7, 0x5a, 0x1e, 0xd9, 0x7b, 0x6a, 0x3a, 0x1d,
+	0xa6, 0x82, 0x5f, 0x04, 0x35, 0xc9, 0xfb, 0x39, 0x8c, 0x9b, 0x8d, 0x18, 0x83, 0x37, 0xf4, 0x42,
+	0x7b, 0x18, 0x3f, 0xd1, 0xa2, 0x53, 0x12, 0x97, 0xd4, 0x78, 0x4e, 0x0a, 0x8f, 0xbb, 0x0f, 0x3b,
+	0xfe, 0xa7, 0xe0, 0xfc, 0x8a, 0x88, 0xf0, 0xe4, 0x2b, 0x41, 0x13, 0x77, 0x0c, 0xdd, 0x2c, 0xd7,
+	0xbc, 0x6e, 0x96, 0xab, 0x40, 0x75, 0x4d, 0xa0, 0x7e, 0x06, 0x43, 0xd9, 0x35, 0xa0, 0x45, 0x19,
+	0x8b, 0x56, 0xdc, 0x47, 0xd0, 0x49, 0xf5, 0xe8, 0x9d, 0xd4, 0xac, 0x82, 0x5e, 0xbd, 0x0a, 0x1e,
+	0xc0, 0x48, 0x93, 0xd5, 0x1a, 0xf8, 0x01, 0xf4, 0x99, 0xa0, 0x09, 0x46, 0x18, 0x6d, 0xde, 0x40,
+	0x9b, 0xab, 0x89, 0x04, 0xaa, 0xcd, 0x7f, 0x0c, 0x1b, 0x46, 0xa3, 0x5a, 0x17, 0x9f, 0xc2, 0x3a,
+	0x97, 0xda, 0x0d, 0x6f, 0xb3, 0xe2, 0xa9, 0x59, 0x05, 0xa6, 0xdd, 0xbf, 0x0b, 0x93, 0x97, 0x9c,
+	0xa4, 0x05, 0x6e, 0x00, 0xa3, 0xf4, 0xed, 0xf6, 0xed, 0xc3, 0x75, 0x8b, 0xb1, 0x74, 0x75, 0x7f,
+	0x97, 0x95, 0x7f, 0xef, 0xc0, 0xe8, 0x05, 0xcb, 0x29, 0xae, 0x98, 0x23, 0x41, 0xf3, 0x4b, 0x1a,
+	0x3f, 0x83, 0xb5, 0x9c, 0x70, 0x92, 0xa0, 0x5a, 0x9c, 0xff, 0x6d, 0x9c, 0xbf, 0xcd, 0xd8, 0x7b,
+	0x21, 0x9b, 0x55, 0x94, 0x75, 0x5f, 0xef, 0x11, 0x0c, 0x2d, 0xf8, 0xbb, 0xe2, 0xeb, 0xd8, 0xf1,
+	0x7d, 0x03, 0x9b, 0x66, 0xf8, 0xe5, 0xf9, 0xe2, 0x13, 0xe8, 0x17, 0x82, 0xe6, 0x66, 0x42, 0x93,
+	0xf6, 0x84, 0x02, 0xd5, 0xec, 0xfa, 0x30, 0x62, 0xa9, 0xa0, 0x3c, 0xa1, 0x11, 0x23, 0x82, 0xea,
+	0x34, 0xd2, 0xc0, 0xfc, 0xdf, 0xc1, 0xa4, 0x56, 0xb6, 0xd4, 0x81, 0xed, 0x51, 0xba, 0x72, 0x37,
+	0x34, 0xb0, 0x25, 0x6e, 0xcd, 0x61, 0x1c, 0xd0, 0x3c, 0x26, 0xe1, 0x15, 0x36, 0x4c, 0x61, 0x3d,
+	0x27, 0x42, 0x50, 0x9e, 0x6a, 0x07, 0x18, 0xd1, 0xdd, 0x81, 0x21, 0x57, 0xcc, 0x84, 0xa6, 0x42,
+	0x8f, 0x69, 0x43, 0xe8, 0xba, 0xe3, 0x98, 0x2c, 0x54, 0x82, 0x70, 0x02, 0x25, 0xf8, 0xf7, 0x60,
+	0xb3, 0xd2, 0xb8, 0x62, 0x9e, 0x8b, 0x60, 0x7c, 0x78, 0x2e, 0x38, 0x09, 0xc5, 0xbb, 0x4e, 0xb2,
+	0x9a, 0x42, 0xcf, 0x9a, 0x82, 0xca, 0x42, 0x09, 0x13, 0x72, 0x62, 0xfd, 0x40, 0x09, 0xfe, 0xbf,
+	0x3b, 0x00, 0x01, 0x5d, 0xd0, 0xf3, 0xe7, 0xb8, 0xe8, 0x31, 0xdb, 0x0b, 0x7a, 0x2e, 0xb4, 0x16,
+	0xf9, 0x8d, 0xc4, 0x42, 0x10, 0x2e, 0xcc, 0x66, 0x97, 0x82, 0x9c, 0x70, 0x1a, 0xe9, 0x04, 0x89,
+	0x9f, 0x78, 0xf6, 0x2d, 0x78, 0x56, 0xe6, 0x68, 0x3a, 0x46, 0x41, 0x4b, 0xee, 0x1d, 0xe8, 0xa7,
+	0x24, 0xa1, 0xd1, 0xb4, 0x2f, 0x57, 0xc4, 0x2d, 0x5c, 0x11, 0xb5, 0xca, 0xbd, 0x6f, 0xb0, 0x4d,
+	0xad, 0x4f, 0xd5, 0xcf, 0x7b, 0x08, 0x50, 0x83, 0xef, 0xb4, 0x3a, 0x9f, 0xc3, 0x66, 0xe5, 0x33,
+	0xed, 0xe6, 0x5d, 0x58, 0x4f, 0x50, 0x0f, 0x35, 0x5b, 0x7c, 0xdc, 0xd4, 0x1f, 0x98, 0xe6, 0x25,
+	0x21, 0x78, 0x04, 0x1b, 0x87, 0x69, 0x98, 0x45, 0x57, 0x2c, 0x13, 0x0f, 0x06, 0x14, 0x9b, 0x59,
+	0xba, 0xd0, 0xac, 0x4a, 0xf6, 0xef, 0xc2, 0xd8, 0x50, 0x57, 0x8c, 0xf7, 0x23, 0xd8, 0x38, 0xa0,
+	0xef, 0xad, 0xec, 0x80, 0xbe, 0x93, 0xb2, 0x10, 0x86, 0xbf, 0x26, 0xc5, 0xc9, 0x72, 0x55, 0xb7,
+	0xc1, 0x21, 0xf1, 0x22, 0xe3, 0x4c, 0x9c, 0x98, 0x73, 0xbf, 0x06, 0x30, 0xcc, 0x59, 0x29, 0xf2,
+	0xd2, 0xac, 0x7e, 0x2d, 0x99, 0x38, 0x5d, 0xab, 0xe2, 0xe4, 0xef, 0xc1, 0x48, 0x29, 0x59, 0x71,
+	0x52, 0x3f, 0x81, 0xf1, 0x93, 0x2c, 0xc9, 0x09, 0xb7, 0x5d, 0x40, 0x0c, 0x83, 0xa0, 0x34, 0xd7,
+	0xfd, 0x3b, 0x73, 0xff, 0x5f, 0x1d, 0xd8, 0xac, 0xba, 0x6b, 0x0d, 0x3b, 0x30, 0x8c, 0xe9, 0x29,
+	0x4d, 0x8b, 0x13, 0x41, 0x59, 0xaa, 0x4f, 0x7b, 0x1b, 0x72, 0xef, 0xc0, 0x56, 0x44, 0x12, 0xca,
+	0x49, 0x39, 0xb3, 0x7b, 0xaa, 0xa5, 0xed, 0xea, 0xa6, 0x67, 0x16, 0xe1, 0x63, 0x18, 0xfd, 0x81,
+	0xf0, 0x6c, 0x76, 0xc6, 0xd2, 0x37, 0x31, 0x55, 0x69, 0xa4, 0x13, 0x0c, 0x11, 0x7b, 0xad, 0x20,
+	0x3c, 0xc9, 0x0b, 0x96, 0xb0, 0x98, 0x70, 0x26, 0x94, 0x03, 0x3a, 0x81, 0x85, 0x18, 0x4b, 0xfb,
+	0xb5, 0xa5, 0x19, 0x0c, 0x0f, 0xd8, 0xf1, 0xf1, 0x0a, 0x66, 0xe2, 0x8e, 0x4c, 0xb2, 0x88, 0x6a,
+	0x67, 0xcb, 0x6f, 0x0c, 0x01, 0x1e, 0x2a, 0x44, 0x68, 0x6f, 0x6b, 0x09, 0x53, 0x42, 0x98, 0xa5,
+	0x72, 0x03, 0xf7, 0xe5, 0x26, 0x37, 0xa2, 0xbf, 0x07, 0x03, 0x54, 0x78, 0x18, 0xb1, 0xcb, 0xa7,
+	0x96, 0xd9, 0xf3, 0xdd, 0x7a, 0xcf, 0xfb, 0xdf, 0xc2, 0x48, 0x4d, 0x50, 0x3b, 0x76, 0x0a, 0xeb,
+	0x65, 0xca, 0x8e, 0x19, 0x8d, 0x34, 0xd1, 0x88, 0xae, 0x0f, 0x7d, 0x1a, 0x31, 0x61, 0xf2, 0xfd,
+	0x08, 0x77, 0x97, 0x51, 0x15, 0xa8, 0xa6, 0x25, 0x19, 0xf8, 0x0c, 0xc6, 0x47, 0x71, 0xb9, 0x60,
+	0xc7, 0x17, 0x57, 0x2e, 0xc1, 0x82, 0xe2, 0x69, 0x25, 0x32, 0xb3, 0x44, 0x6a, 0xc0, 0xfd, 0x10,
+	0x20, 0x21, 0xe7, 0xb3, 0x98, 0xa6, 0x0b, 0x71, 0x22, 0x87, 0xed, 0x07, 0x4e, 0x42, 0xce, 0x9f,
+	0x49, 0x40, 0x6e, 0x95, 0x73, 0x59, 0x2a, 0x2d, 0x74, 0x2a, 0xaa, 0x64, 0x4c, 0xc4, 0x95, 0xe2,
+	0x15, 0x97, 0xe5, 0x5f, 0x61, 0xf3, 0x65, 0xf6, 0x86, 0xa6, 0x6f, 0x2b, 0x91, 0xdb, 0x95, 0xb8,
+	0x3a, 0xd9, 0xd5, 0xcc, 0x3a, 0x29, 0x9a, 0x13, 0x9b, 0xdb, 0x86, 0x2e, 0x86, 0x6b, 0x00, 0xcd,
+	0x29, 0x44, 0x96, 0xcf, 0x54, 0x61, 0xd9, 0xd7, 0xd6, 0x8a, 0x2c, 0x7f, 0x8d, 0x80, 0xff, 0x17,
+	0xe8, 0x4b, 0xfd, 0xff, 0x57, 0x72, 0xfe, 0x10, 0x00, 0xcb, 0xdb, 0x99, 0xea, 0xac, 0x8a, 0x57,
+	0x07, 0x91, 0x23, 0x49, 0xb8, 0x05, 0x03, 0xd9, 0x8c, 0x2c, 0x55, 0xbf, 0xae, 0xa3, 0x7c, 0x98,
+	0x46, 0xfe, 0x97, 0x30, 0xa9, 0xcd, 0xd7, 0x2e, 0xfb, 0x18, 0xd6, 0x04, 0x62, 0x26, 0xa7, 0x3a,
+	0x18, 0x75, 0xd9, 0x2b, 0xd0, 0x0d, 0x4b, 0xfc, 0xf8, 0x05, 0x6c, 0x04, 0x34, 0x8d, 0x28, 0x37,
+	0x5e, 0xf4, 0x60, 0x20, 0x68, 0x92, 0xc7, 0x78, 0x70, 0x2b, 0x9b, 0x2a, 0x19, 0x6d, 0x8d, 0x88,
+	0x20, 0xc6, 0xa7, 0xf8, 0x8d, 0x69, 0xce, 0x0c, 0xb0, 0x62, 0xe8, 0x7e, 0x8b, 0x2a, 0xa3, 0x2b,
+	0x8f, 0xd0, 0xdb, 0xe0, 0x44, 0x54, 0xd0, 0x50, 0x64, 0xbc, 0xd0, 0xa5, 0x43, 0x0d, 0x28, 0xd7,
+	0x5e, 0xc4, 0x66, 0xeb, 0x29, 0xc1, 0x3f, 0x84, 0xf5, 0xa7, 0x2c, 0xc5, 0xb4, 0x2b, 0xe3, 0x71,
+	0x91, 0xd3, 0x2a, 0x1e, 0x17, 0x39, 0x5d, 0x35, 0x1e, 0xfe, 0xef, 0x61, 0x6c, 0x66, 0xb6, 0xd4,
+	0x96, 0x1f, 0xc1, 0xe0, 0x58, 0xa9, 0x31, 0x3b, 0x6b, 0x88, 0x3e, 0xd6, 0xaa, 0x83, 0xaa, 0x71,
+	0xc9, 0xde, 0xfa, 0x21, 0x6c, 0x1f, 0x48, 0x23, 0x9e, 0x91, 0x74, 0x51, 0x92, 0xc5, 0x15, 0xf7,
+	0xa4, 0xdf, 0xc0, 0x86, 0xe9, 0x70, 0x14, 0x66, 0x5c, 0x5e, 0x38, 0x62, 0x0d, 0x98, 0x70, 0x18,
+	0x19, 0x53, 0x5c, 0x98, 0xa5, 0xc7, 0x2c, 0xa2, 0x69, 0xa8, 0xce, 0xdd, 0x4e, 0x60, 0x21, 0x3e,
+	0x81, 0x9b, 0x6d, 0x9d, 0xda, 0xac, 0x9b, 0xb0, 0xa6, 0xee, 0x26, 0x7a, 0x4c, 0x2d, 0xb9, 0x77,
+	0xc0, 0x31, 0xa3, 0x1b, 0x0b, 0xaf, 0xa3, 0x85, 0x8d, 0x39, 0x05, 0x75, 0x1f, 0x9f, 0xc0, 0xe6,
+	0x4b, 0x5e, 0xa6, 0x21, 0x11, 0xef, 0xb0, 0x0d, 0x27, 0xd0, 0x4b, 0xc8, 0xb9, 0xde, 0x88, 0xf8,
+	0x89, 0x56, 0xd2, 0x38, 0x66, 0x79, 0xc1, 0x4c, 0x89, 0x56, 0xc9, 0xfe, 0x7d, 0x98, 0xd4, 0x2a,
+	0x56, 0x5c, 0x62, 0xf7, 0x60, 0xf8, 0x9a, 0x93, 0x7c, 0xf9, 0x94, 0xf0, 0xb6, 0xc8, 0x22, 0x71,
+	0x22, 0x09, 0xfd, 0x40, 0x09, 0x78, 0x2e, 0x2a, 0xca, 0x8a, 0x2a, 0xfe, 0xd6, 0x81, 0xc9, 0x51,
+	0x39, 0x4f, 0x98, 0xf8, 0x3a, 0x9b, 0x1b, 0x45, 0x9f, 0x40, 0x7f, 0x8e, 0x85, 0x8b, 0x24, 0xea,
+	0x3a, 0xdb, 0xbe, 0x11, 0x05, 0xaa, 0xd9, 0xbd, 0x03, 0x83, 0x5c, 0xd7, 0xd0, 0x72, 0xcc, 0xe1,
+	0xfd, 0x2d, 0xbb, 0x24, 0x37, 0xbd, 0xab, 0x4e, 0x78, 0xe0, 0x85, 0x24, 0x8e, 0xe7, 0x24, 0x7c,
+	0x33, 0x2b, 0x79, 0x6c, 0x6a, 0x5c, 0x83, 0xbd, 0xe2, 0xb1, 0x7f, 0x1b, 0xc0, 0x9a, 0xc9, 0x18,
+	0xba, 0xcc, 0x1c, 0x0b, 0x5d, 0x16, 0xf9, 0xff, 0xec, 0xc2, 0x50, 0x36, 0x6b, 0xf3, 0x5a, 0xed,
+	0x72, 0x45, 0x08, 0x22, 0x4a, 0x73, 0x55, 0xd2, 0x92, 0xdc, 0xf2, 0x59, 0x4a, 0xf5, 0x2e, 0x91,
+	0xdf, 0xe8, 0x40, 0x91, 0x09, 0x12, 0x9b, 0xeb, 0xb6, 0x14, 0xec, 0x6b, 0x5b, 0xff, 0xed, 0xd7,
+	0x36, 0xf7, 0xae, 0x65, 0xfe, 0x9a, 0x34, 0xff, 0x46, 0xd3, 0x7c, 0x35, 0x49, 0xcb, 0xfe, 0x9b,
+	0xb0, 0xc6, 0x29, 0x29, 0xb2, 0x54, 0xde, 0xc9, 0x9d, 0x40, 0x4b, 0x98, 0x41, 0x43, 0x4e, 0x89,
+	0xa0, 0xd1, 0x8c, 0xa8, 0x1b, 0xb9, 0x13, 0x38, 0x1a, 0xd9, 0x17, 0xd8, 0x5c, 0xe6, 0x91, 0x69,
+	0x76, 0x54, 0xb3, 0x46, 0xf6, 0x85, 0x89, 0x2a, 0xd4, 0x51, 0xfd, 0x47, 0x07, 0x36, 0x0f, 0x68,
+	0xcc, 0x4e, 0x29, 0xbf, 0xd8, 0x17, 0x98, 0xf8, 0xe4, 0x01, 0x4e, 0xd4, 0xa7, 0xae, 0x5d, 0x8c,
+	0x28, 0x73, 0x0d, 0x4b, 0x68, 0x75, 0x48, 0xb3, 0x84, 0xba, 0xdf, 0x87, 0xa1, 0x72, 0xdd, 0x2c,
+	0x34, 0x15, 0x42, 0x2f, 0x00, 0x05, 0x3d, 0xc1, 0x3a, 0xe1, 0x06, 0xf4, 0x29, 0xe7, 0x19, 0x37,
+	0x77, 0x11, 0x29, 0x20, 0x2d, 0x2a, 0x39, 0x11, 0x2c, 0x4b, 0x67, 0x89, 0x79, 0xae, 0x00, 0x03,
+	0x3d, 0x2f, 0xfc, 0x6f, 0x61, 0xfb, 0xeb, 0x6c, 0xae, 0xe7, 0xc6, 0x68, 0x51, 0x45, 0xf2, 0x01,
+	0x40, 0x54, 0xa1, 0x3a, 0xf5, 0xcb, 0xd5, 0xd4, 0xb2, 0x23, 0xb0, 0xba, 0x2d, 0x59, 0xcf, 0x87,
+	0x30, 0xdc, 0x2f, 0xc5, 0x89, 0x75, 0x0c, 0xbc, 0x2a, 0x28, 0xc7, 0xda, 0xdf, 0xe4, 0x1d, 0x23,
+	0x63, 0xdb, 0x0b, 0x52, 0x14, 0x78, 0x32, 0x9a, 0xaa, 0xd7, 0xc8, 0xfe, 0x4f, 0x61, 0xa4, 0x86,
+	0xa9, 0x1f, 0x79, 0xe4, 0xd9, 0xa3, 0x07, 0x51, 0xc2, 0x65, 0xf5, 0xf7, 0xff, 0x33, 0x84, 0x8d,
+	0x23, 0xf9, 0x76, 0x79, 0x44, 0xf9, 0x29, 0x0b, 0xa9, 0xfb, 0x18, 0x9c, 0xea, 0xed, 0xd1, 0x95,
+	0xeb, 0xa3, 0xfd, 0x88, 0xe9, 0x6d, 0xb7, 0x50, 0xa5, 0xd3, 0xff, 0x00, 0xb9, 0xd5, 0x43, 0xa2,
+	0xe2, 0xb6, 0x5f, 0x24, 0xbd, 0xed, 0x16, 0x6a, 0x73, 0xab, 0x57, 0x41, 0xc5, 0x6d, 0x3f, 0x2f,
+	0x7a, 0xdb, 0x2d, 0xb4, 0xe2, 0xee, 0x41, 0x5f, 0x3e, 0xf5, 0xb9, 0x72, 0xe7, 0xdb, 0xef, 0x84,
+	0xde, 0x75, 0x0b, 0xb1, 0x75, 0x55, 0x6f, 0x6d, 0x4a, 0x57, 0xfb, 0xe1, 0xcf, 0xdb, 0x6e, 0xa1,
+	0x15, 0xf7, 0x33, 0x58, 0xd7, 0xef, 0x47, 0xae, 0xdb, 0x78, 0x4c, 0x52, 0xbc, 0xad, 0x25, 0x0f,
+	0x4c, 0x6a, 0x86, 0x72, 0x4b, 0xba, 0x97, 0x72, 0x93, 0x77, 0xdd, 0x42, 0xaa, 0xfe, 0xbf, 0x00,
+	0xa7, 0x7a, 0x2f, 0xd1, 0xde, 0x68, 0x3d, 0xb8, 0x78, 0xdb, 0x2d, 0xd4, 0x70, 0x77, 0x3b, 0x77,
+	0x3b, 0xee, 0xe7, 0x30, 0x30, 0xdb, 0xda, 0x5d, 0x96, 0xe3, 0xbc, 0xa5, 0x3b, 0x5f, 0x99, 0xa7,
+	0x2f, 0xe7, 0xca, 0xbc, 0xe6, 0xdb, 0x80, 0xb7, 0xd5, 0xc0, 0x6c, 0x96, 0xbe, 0x6b, 0x2a, 0x56,
+	0xf3, 0xb2, 0xee, 0x6d, 0x35, 0xb0, 0x8a, 0x75, 0x0f, 0xd6, 0xd4, 0xbd, 0xd0, 0x95, 0x3e, 0x68,
+	0x5c, 0x2f, 0x3d, 0xd7, 0x86, 0x6c, 0xca, 0x01, 0xad, 0x29, 0x07, 0xf4, 0x12, 0xa5, 0x79, 0xf9,
+	0xf3, 0x3f, 0x70, 0x7f, 0x0c, 0xd7, 0xf0, 0xe6, 0xe5, 0xca, 0xbc, 0x68, 0x5d, 0xf4, 0xbc, 0x49,
+	0x0d, 0xd8, 0x86, 0xe8, 0x7b, 0x94, 0x32, 0xa4, 0x79, 0x07, 0xf3, 0xb6, 0x1a, 0x98, 0xad, 0x02,
+	0xcb, 0x7c, 0xa5, 0xc2, 0xba, 0xcc, 0x78, 0x93, 0x1a, 0xb0, 0x55, 0xe8, 0xaa, 0x5b, 0xa9, 0x68,
+	0xd6, 0xfe, 0xde, 0x56, 0x03, 0xab, 0x58, 0x9f, 0xc3, 0xc0, 0x54, 0x9e, 0x2a, 0xa0, 0xad, 0x32,
+	0xdc, 0xbb, 0xd1, 0x04, 0x6d, 0x8f, 0xa9, 0x42, 0x51, 0x79, 0xac, 0x51, 0x75, 0x7a, 0xae, 0x0d,
+	0x35, 0x29, 0x58, 0x8f, 0x19, 0x8a, 0x55, 0x35, 0x7a, 0xae, 0x0d, 0x55, 0x94, 0xaf, 0x60, 0xdc,
+	0xac, 0x79, 0xdc, 0x5b, 0x2a, 0x18, 0x4b, 0x6a, 0x2f, 0xcf, 0x5b, 0xd6, 0xd4, 0xb0, 0x54, 0x17,
+	0x1e, 0xda, 0xd2, 0x66, 0xa5, 0xe3, 0xdd, 0x68, 0x82, 0x76, 0x14, 0xb0, 0x94, 0x50, 0x51, 0xb0,
+	0xea, 0x10, 0x6f, 0x52, 0x03, 0x56, 0x14, 0x9c, 0xaa, 0x8c, 0x50, 0x1b, 0xac, 0x5d, 0x55, 0x78,
+	0x72, 0x1c, 0xeb, 0xf0, 0x96, 0x2a, 0xd6, 0xbe, 0xa4, 0x92, 0x32, 0xae, 0x1a, 0xaf, 0xec, 0xbc,
+	0x07, 0xce, 0x13, 0x92, 0x86, 0x34, 0x5e, 0xb1, 0xff, 0x17, 0x30, 0x51, 0x83, 0xd7, 0xa7, 0xcd,
+	0x25, 0xda, 0x2d, 0x2d, 0x5f, 0x3e, 0x90, 0x94, 0x03, 0xf0, 0x10, 0x50, 0x0e, 0xb0, 0x4e, 0x15,
+	0x6f, 0x52, 0x03, 0xa6, 0xf3, 0x7c, 0x4d, 0xfe, 0xa8, 0x7a, 0xf0, 0xbf, 0x01, 0x00, 0xeb, 0x7a,
+	0x86, 0xfe, 0xbb, 0x1a, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// StringServiceClient is the client API for StringService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type StringServiceClient interface {
+	Uppercase(ctx context.Context, in *UppercaseRequest, opts ...grpc.CallOption) (*UppercaseResponse, error)
+	Lowercase(ctx context.Context, in *LowercaseRequest, opts ...grpc.CallOption) (*LowercaseResponse, error)
+	TitleCase(ctx context.Context, in *TitleCaseRequest, opts ...grpc.CallOption) (*TitleCaseResponse, error)
+	Count(ctx context.Context, in *CountRequest, opts ...grpc.CallOption) (*CountResponse, error)
+	Normalize(ctx context.Context, in *NormalizeRequest, opts ...grpc.CallOption) (*NormalizeResponse, error)
+	Analyze(ctx context.Context, in *AnalyzeRequest, opts ...grpc.CallOption) (*AnalyzeResponse, error)
+	Batch(ctx context.Context, in *BatchRequest, opts ...grpc.CallOption) (*BatchResponse, error)
+	Transform(ctx context.Context, opts ...grpc.CallOption) (StringService_TransformClient, error)
+	Pipeline(ctx context.Context, in *PipelineRequest, opts ...grpc.CallOption) (*PipelineResponse, error)
+	Replace(ctx context.Context, in *ReplaceRequest, opts ...grpc.CallOption) (*ReplaceResponse, error)
+	Extract(ctx context.Context, in *ExtractRequest, opts ...grpc.CallOption) (*ExtractResponse, error)
+	Encode(ctx context.Context, in *EncodeRequest, opts ...grpc.CallOption) (*EncodeResponse, error)
+	Decode(ctx context.Context, in *DecodeRequest, opts ...grpc.CallOption) (*DecodeResponse, error)
+	Hash(ctx context.Context, in *HashRequest, opts ...grpc.CallOption) (*HashResponse, error)
+	Compare(ctx context.Context, in *CompareRequest, opts ...grpc.CallOption) (*CompareResponse, error)
+	Diff(ctx context.Context, in *DiffRequest, opts ...grpc.CallOption) (*DiffResponse, error)
+	Slugify(ctx context.Context, in *SlugifyRequest, opts ...grpc.CallOption) (*SlugifyResponse, error)
+	Tokenize(ctx context.Context, in *TokenizeRequest, opts ...grpc.CallOption) (*TokenizeResponse, error)
+	Render(ctx context.Context, in *RenderRequest, opts ...grpc.CallOption) (*RenderResponse, error)
+	Redact(ctx context.Context, in *RedactRequest, opts ...grpc.CallOption) (*RedactResponse, error)
+	DetectLanguage(ctx context.Context, in *DetectLanguageRequest, opts ...grpc.CallOption) (*DetectLanguageResponse, error)
+	Truncate(ctx context.Context, in *TruncateRequest, opts ...grpc.CallOption) (*TruncateResponse, error)
+	Wrap(ctx context.Context, in *WrapRequest, opts ...grpc.CallOption) (*WrapResponse, error)
+	SubmitJob(ctx context.Context, in *SubmitJobRequest, opts ...grpc.CallOption) (*JobResponse, error)
+	GetJob(ctx context.Context, in *JobRequest, opts ...grpc.CallOption) (*JobResponse, error)
+	CancelJob(ctx context.Context, in *JobRequest, opts ...grpc.CallOption) (*JobResponse, error)
+	GetJobDeliveries(ctx context.Context, in *JobRequest, opts ...grpc.CallOption) (*JobDeliveriesResponse, error)
+	Auth(ctx context.Context, in *AuthRequest, opts ...grpc.CallOption) (*AuthResponse, error)
+}
+
+type stringServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewStringServiceClient(cc *grpc.ClientConn) StringServiceClient {
+	return &stringServiceClient{cc}
+}
+
+func (c *stringServiceClient) Uppercase(ctx context.Context, in *UppercaseRequest, opts ...grpc.CallOption) (*UppercaseResponse, error) {
+	out := new(UppercaseResponse)
+	err := c.cc.Invoke(ctx, "/pb.StringService/Uppercase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stringServiceClient) Lowercase(ctx context.Context, in *LowercaseRequest, opts ...grpc.CallOption) (*LowercaseResponse, error) {
+	out := new(LowercaseResponse)
+	err := c.cc.Invoke(ctx, "/pb.StringService/Lowercase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stringServiceClient) TitleCase(ctx context.Context, in *TitleCaseRequest, opts ...grpc.CallOption) (*TitleCaseResponse, error) {
+	out := new(TitleCaseResponse)
+	err := c.cc.Invoke(ctx, "/pb.StringService/TitleCase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stringServiceClient) Count(ctx context.Context, in *CountRequest, opts ...grpc.CallOption) (*CountResponse, error) {
+	out := new(CountResponse)
+	err := c.cc.Invoke(ctx, "/pb.StringService/Count", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stringServiceClient) Normalize(ctx context.Context, in *NormalizeRequest, opts ...grpc.CallOption) (*NormalizeResponse, error) {
+	out := new(NormalizeResponse)
+	err := c.cc.Invoke(ctx, "/pb.StringService/Normalize", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stringServiceClient) Analyze(ctx context.Context, in *AnalyzeRequest, opts ...grpc.CallOption) (*AnalyzeResponse, error) {
+	out := new(AnalyzeResponse)
+	err := c.cc.Invoke(ctx, "/pb.StringService/Analyze", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stringServiceClient) Batch(ctx context.Context, in *BatchRequest, opts ...grpc.CallOption) (*BatchResponse, error) {
+	out := new(BatchResponse)
+	err := c.cc.Invoke(ctx, "/pb.StringService/Batch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stringServiceClient) Transform(ctx context.Context, opts ...grpc.CallOption) (StringService_TransformClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_StringService_serviceDesc.Streams[0], "/pb.StringService/Transform", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &stringServiceTransformClient{stream}
+	return x, nil
+}
+
+type StringService_TransformClient interface {
+	Send(*TransformRequest) error
+	Recv() (*TransformResponse, error)
+	grpc.ClientStream
+}
+
+type stringServiceTransformClient struct {
+	grpc.ClientStream
+}
+
+func (x *stringServiceTransformClient) Send(m *TransformRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *stringServiceTransformClient) Recv() (*TransformResponse, error) {
+	m := new(TransformResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *stringServiceClient) Pipeline(ctx context.Context, in *PipelineRequest, opts ...grpc.CallOption) (*PipelineResponse, error) {
+	out := new(PipelineResponse)
+	err := c.cc.Invoke(ctx, "/pb.StringService/Pipeline", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stringServiceClient) Replace(ctx context.Context, in *ReplaceRequest, opts ...grpc.CallOption) (*ReplaceResponse, error) {
+	out := new(ReplaceResponse)
+	err := c.cc.Invoke(ctx, "/pb.StringService/Replace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stringServiceClient) Extract(ctx context.Context, in *ExtractRequest, opts ...grpc.CallOption) (*ExtractResponse, error) {
+	out := new(ExtractResponse)
+	err := c.cc.Invoke(ctx, "/pb.StringService/Extract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stringServiceClient) Encode(ctx context.Context, in *EncodeRequest, opts ...grpc.CallOption) (*EncodeResponse, error) {
+	out := new(EncodeResponse)
+	err := c.cc.Invoke(ctx, "/pb.StringService/Encode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stringServiceClient) Decode(ctx context.Context, in *DecodeRequest, opts ...grpc.CallOption) (*DecodeResponse, error) {
+	out := new(DecodeResponse)
+	err := c.cc.Invoke(ctx, "/pb.StringService/Decode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stringServiceClient) Hash(ctx context.Context, in *HashRequest, opts ...grpc.CallOption) (*HashResponse, error) {
+	out := new(HashResponse)
+	err := c.cc.Invoke(ctx, "/pb.StringService/Hash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stringServiceClient) Compare(ctx context.Context, in *CompareRequest, opts ...grpc.CallOption) (*CompareResponse, error) {
+	out := new(CompareResponse)
+	err := c.cc.Invoke(ctx, "/pb.StringService/Compare", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stringServiceClient) Diff(ctx context.Context, in *DiffRequest, opts ...grpc.CallOption) (*DiffResponse, error) {
+	out := new(DiffResponse)
+	err := c.cc.Invoke(ctx, "/pb.StringService/Diff", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stringServiceClient) Slugify(ctx context.Context, in *SlugifyRequest, opts ...grpc.CallOption) (*SlugifyResponse, error) {
+	out := new(SlugifyResponse)
+	err := c.cc.Invoke(ctx, "/pb.StringService/Slugify", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stringServiceClient) Tokenize(ctx context.Context, in *TokenizeRequest, opts ...grpc.CallOption) (*TokenizeResponse, error) {
+	out := new(TokenizeResponse)
+	err := c.cc.Invoke(ctx, "/pb.StringService/Tokenize", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stringServiceClient) Render(ctx context.Context, in *RenderRequest, opts ...grpc.CallOption) (*RenderResponse, error) {
+	out := new(RenderResponse)
+	err := c.cc.Invoke(ctx, "/pb.StringService/Render", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stringServiceClient) Redact(ctx context.Context, in *RedactRequest, opts ...grpc.CallOption) (*RedactResponse, error) {
+	out := new(RedactResponse)
+	err := c.cc.Invoke(ctx, "/pb.StringService/Redact", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stringServiceClient) DetectLanguage(ctx context.Context, in *DetectLanguageRequest, opts ...grpc.CallOption) (*DetectLanguageResponse, error) {
+	out := new(DetectLanguageResponse)
+	err := c.cc.Invoke(ctx, "/pb.StringService/DetectLanguage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stringServiceClient) Truncate(ctx context.Context, in *TruncateRequest, opts ...grpc.CallOption) (*TruncateResponse, error) {
+	out := new(TruncateResponse)
+	err := c.cc.Invoke(ctx, "/pb.StringService/Truncate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stringServiceClient) Wrap(ctx context.Context, in *WrapRequest, opts ...grpc.CallOption) (*WrapResponse, error) {
+	out := new(WrapResponse)
+	err := c.cc.Invoke(ctx, "/pb.StringService/Wrap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stringServiceClient) SubmitJob(ctx context.Context, in *SubmitJobRequest, opts ...grpc.CallOption) (*JobResponse, error) {
+	out := new(JobResponse)
+	err := c.cc.Invoke(ctx, "/pb.StringService/SubmitJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stringServiceClient) GetJob(ctx context.Context, in *JobRequest, opts ...grpc.CallOption) (*JobResponse, error) {
+	out := new(JobResponse)
+	err := c.cc.Invoke(ctx, "/pb.StringService/GetJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stringServiceClient) CancelJob(ctx context.Context, in *JobRequest, opts ...grpc.CallOption) (*JobResponse, error) {
+	out := new(JobResponse)
+	err := c.cc.Invoke(ctx, "/pb.StringService/CancelJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stringServiceClient) GetJobDeliveries(ctx context.Context, in *JobRequest, opts ...grpc.CallOption) (*JobDeliveriesResponse, error) {
+	out := new(JobDeliveriesResponse)
+	err := c.cc.Invoke(ctx, "/pb.StringService/GetJobDeliveries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stringServiceClient) Auth(ctx context.Context, in *AuthRequest, opts ...grpc.CallOption) (*AuthResponse, error) {
+	out := new(AuthResponse)
+	err := c.cc.Invoke(ctx, "/pb.StringService/Auth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
 // StringServiceServer is the server API for StringService service.
 type StringServiceServer interface {
 	Uppercase(context.Context, *UppercaseRequest) (*UppercaseResponse, error)
+	Lowercase(context.Context, *LowercaseRequest) (*LowercaseResponse, error)
+	TitleCase(context.Context, *TitleCaseRequest) (*TitleCaseResponse, error)
 	Count(context.Context, *CountRequest) (*CountResponse, error)
+	Normalize(context.Context, *NormalizeRequest) (*NormalizeResponse, error)
+	Analyze(context.Context, *AnalyzeRequest) (*AnalyzeResponse, error)
+	Batch(context.Context, *BatchRequest) (*BatchResponse, error)
+	Transform(StringService_TransformServer) error
+	Pipeline(context.Context, *PipelineRequest) (*PipelineResponse, error)
+	Replace(context.Context, *ReplaceRequest) (*ReplaceResponse, error)
+	Extract(context.Context, *ExtractRequest) (*ExtractResponse, error)
+	Encode(context.Context, *EncodeRequest) (*EncodeResponse, error)
+	Decode(context.Context, *DecodeRequest) (*DecodeResponse, error)
+	Hash(context.Context, *HashRequest) (*HashResponse, error)
+	Compare(context.Context, *CompareRequest) (*CompareResponse, error)
+	Diff(context.Context, *DiffRequest) (*DiffResponse, error)
+	Slugify(context.Context, *SlugifyRequest) (*SlugifyResponse, error)
+	Tokenize(context.Context, *TokenizeRequest) (*TokenizeResponse, error)
+	Render(context.Context, *RenderRequest) (*RenderResponse, error)
+	Redact(context.Context, *RedactRequest) (*RedactResponse, error)
+	DetectLanguage(context.Context, *DetectLanguageRequest) (*DetectLanguageResponse, error)
+	Truncate(context.Context, *TruncateRequest) (*TruncateResponse, error)
+	Wrap(context.Context, *WrapRequest) (*WrapResponse, error)
+	SubmitJob(context.Context, *SubmitJobRequest) (*JobResponse, error)
+	GetJob(context.Context, *JobRequest) (*JobResponse, error)
+	CancelJob(context.Context, *JobRequest) (*JobResponse, error)
+	GetJobDeliveries(context.Context, *JobRequest) (*JobDeliveriesResponse, error)
 	Auth(context.Context, *AuthRequest) (*AuthResponse, error)
 }
 
-// UnimplementedStringServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedStringServiceServer struct {
+// UnimplementedStringServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedStringServiceServer struct {
+}
+
+func (*UnimplementedStringServiceServer) Uppercase(ctx context.Context, req *UppercaseRequest) (*UppercaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Uppercase not implemented")
+}
+func (*UnimplementedStringServiceServer) Lowercase(ctx context.Context, req *LowercaseRequest) (*LowercaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Lowercase not implemented")
+}
+func (*UnimplementedStringServiceServer) TitleCase(ctx context.Context, req *TitleCaseRequest) (*TitleCaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TitleCase not implemented")
+}
+func (*UnimplementedStringServiceServer) Count(ctx context.Context, req *CountRequest) (*CountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Count not implemented")
+}
+func (*UnimplementedStringServiceServer) Normalize(ctx context.Context, req *NormalizeRequest) (*NormalizeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Normalize not implemented")
+}
+func (*UnimplementedStringServiceServer) Analyze(ctx context.Context, req *AnalyzeRequest) (*AnalyzeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Analyze not implemented")
+}
+func (*UnimplementedStringServiceServer) Batch(ctx context.Context, req *BatchRequest) (*BatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Batch not implemented")
+}
+func (*UnimplementedStringServiceServer) Transform(srv StringService_TransformServer) error {
+	return status.Errorf(codes.Unimplemented, "method Transform not implemented")
+}
+func (*UnimplementedStringServiceServer) Pipeline(ctx context.Context, req *PipelineRequest) (*PipelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pipeline not implemented")
+}
+func (*UnimplementedStringServiceServer) Replace(ctx context.Context, req *ReplaceRequest) (*ReplaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Replace not implemented")
+}
+func (*UnimplementedStringServiceServer) Extract(ctx context.Context, req *ExtractRequest) (*ExtractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Extract not implemented")
+}
+func (*UnimplementedStringServiceServer) Encode(ctx context.Context, req *EncodeRequest) (*EncodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Encode not implemented")
+}
+func (*UnimplementedStringServiceServer) Decode(ctx context.Context, req *DecodeRequest) (*DecodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Decode not implemented")
+}
+func (*UnimplementedStringServiceServer) Hash(ctx context.Context, req *HashRequest) (*HashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Hash not implemented")
+}
+func (*UnimplementedStringServiceServer) Compare(ctx context.Context, req *CompareRequest) (*CompareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Compare not implemented")
+}
+func (*UnimplementedStringServiceServer) Diff(ctx context.Context, req *DiffRequest) (*DiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Diff not implemented")
+}
+func (*UnimplementedStringServiceServer) Slugify(ctx context.Context, req *SlugifyRequest) (*SlugifyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Slugify not implemented")
+}
+func (*UnimplementedStringServiceServer) Tokenize(ctx context.Context, req *TokenizeRequest) (*TokenizeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Tokenize not implemented")
+}
+func (*UnimplementedStringServiceServer) Render(ctx context.Context, req *RenderRequest) (*RenderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Render not implemented")
+}
+func (*UnimplementedStringServiceServer) Redact(ctx context.Context, req *RedactRequest) (*RedactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Redact not implemented")
+}
+func (*UnimplementedStringServiceServer) DetectLanguage(ctx context.Context, req *DetectLanguageRequest) (*DetectLanguageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DetectLanguage not implemented")
+}
+func (*UnimplementedStringServiceServer) Truncate(ctx context.Context, req *TruncateRequest) (*TruncateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Truncate not implemented")
+}
+func (*UnimplementedStringServiceServer) Wrap(ctx context.Context, req *WrapRequest) (*WrapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Wrap not implemented")
+}
+func (*UnimplementedStringServiceServer) SubmitJob(ctx context.Context, req *SubmitJobRequest) (*JobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitJob not implemented")
+}
+func (*UnimplementedStringServiceServer) GetJob(ctx context.Context, req *JobRequest) (*JobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJob not implemented")
+}
+func (*UnimplementedStringServiceServer) CancelJob(ctx context.Context, req *JobRequest) (*JobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelJob not implemented")
+}
+func (*UnimplementedStringServiceServer) GetJobDeliveries(ctx context.Context, req *JobRequest) (*JobDeliveriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobDeliveries not implemented")
+}
+func (*UnimplementedStringServiceServer) Auth(ctx context.Context, req *AuthRequest) (*AuthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Auth not implemented")
+}
+
+func RegisterStringServiceServer(s *grpc.Server, srv StringServiceServer) {
+	s.RegisterService(&_StringService_serviceDesc, srv)
+}
+
+func _StringService_Uppercase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UppercaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StringServiceServer).Uppercase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.StringService/Uppercase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StringServiceServer).Uppercase(ctx, req.(*UppercaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StringService_Lowercase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LowercaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StringServiceServer).Lowercase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.StringService/Lowercase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StringServiceServer).Lowercase(ctx, req.(*LowercaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StringService_TitleCase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TitleCaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StringServiceServer).TitleCase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.StringService/TitleCase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StringServiceServer).TitleCase(ctx, req.(*TitleCaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StringService_Count_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StringServiceServer).Count(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.StringService/Count",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StringServiceServer).Count(ctx, req.(*CountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StringService_Normalize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NormalizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StringServiceServer).Normalize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.StringService/Normalize",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StringServiceServer).Normalize(ctx, req.(*NormalizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StringService_Analyze_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnalyzeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StringServiceServer).Analyze(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.StringService/Analyze",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StringServiceServer).Analyze(ctx, req.(*AnalyzeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StringService_Batch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StringServiceServer).Batch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.StringService/Batch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StringServiceServer).Batch(ctx, req.(*BatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StringService_Transform_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(StringServiceServer).Transform(&stringServiceTransformServer{stream})
+}
+
+type StringService_TransformServer interface {
+	Send(*TransformResponse) error
+	Recv() (*TransformRequest, error)
+	grpc.ServerStream
+}
+
+type stringServiceTransformServer struct {
+	grpc.ServerStream
+}
+
+func (x *stringServiceTransformServer) Send(m *TransformResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *stringServiceTransformServer) Recv() (*TransformRequest, error) {
+	m := new(TransformRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _StringService_Pipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PipelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StringServiceServer).Pipeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.StringService/Pipeline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StringServiceServer).Pipeline(ctx, req.(*PipelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StringService_Replace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StringServiceServer).Replace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.StringService/Replace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StringServiceServer).Replace(ctx, req.(*ReplaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StringService_Extract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StringServiceServer).Extract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.StringService/Extract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StringServiceServer).Extract(ctx, req.(*ExtractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StringService_Encode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EncodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StringServiceServer).Encode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.StringService/Encode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StringServiceServer).Encode(ctx, req.(*EncodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (*UnimplementedStringServiceServer) Uppercase(ctx context.Context, req *UppercaseRequest) (*UppercaseResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Uppercase not implemented")
+func _StringService_Decode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StringServiceServer).Decode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.StringService/Decode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StringServiceServer).Decode(ctx, req.(*DecodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedStringServiceServer) Count(ctx context.Context, req *CountRequest) (*CountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Count not implemented")
+
+func _StringService_Hash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StringServiceServer).Hash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.StringService/Hash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StringServiceServer).Hash(ctx, req.(*HashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedStringServiceServer) Auth(ctx context.Context, req *AuthRequest) (*AuthResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Auth not implemented")
+
+func _StringService_Compare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StringServiceServer).Compare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.StringService/Compare",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StringServiceServer).Compare(ctx, req.(*CompareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterStringServiceServer(s *grpc.Server, srv StringServiceServer) {
-	s.RegisterService(&_StringService_serviceDesc, srv)
+func _StringService_Diff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StringServiceServer).Diff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.StringService/Diff",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StringServiceServer).Diff(ctx, req.(*DiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _StringService_Uppercase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UppercaseRequest)
+func _StringService_Slugify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SlugifyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StringServiceServer).Uppercase(ctx, in)
+		return srv.(StringServiceServer).Slugify(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.StringService/Uppercase",
+		FullMethod: "/pb.StringService/Slugify",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StringServiceServer).Uppercase(ctx, req.(*UppercaseRequest))
+		return srv.(StringServiceServer).Slugify(ctx, req.(*SlugifyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StringService_Count_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CountRequest)
+func _StringService_Tokenize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TokenizeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StringServiceServer).Count(ctx, in)
+		return srv.(StringServiceServer).Tokenize(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.StringService/Count",
+		FullMethod: "/pb.StringService/Tokenize",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StringServiceServer).Count(ctx, req.(*CountRequest))
+		return srv.(StringServiceServer).Tokenize(ctx, req.(*TokenizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StringService_Render_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StringServiceServer).Render(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.StringService/Render",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StringServiceServer).Render(ctx, req.(*RenderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StringService_Redact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StringServiceServer).Redact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.StringService/Redact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StringServiceServer).Redact(ctx, req.(*RedactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StringService_DetectLanguage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DetectLanguageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StringServiceServer).DetectLanguage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.StringService/DetectLanguage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StringServiceServer).DetectLanguage(ctx, req.(*DetectLanguageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StringService_Truncate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TruncateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StringServiceServer).Truncate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.StringService/Truncate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StringServiceServer).Truncate(ctx, req.(*TruncateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StringService_Wrap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WrapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StringServiceServer).Wrap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.StringService/Wrap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StringServiceServer).Wrap(ctx, req.(*WrapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StringService_SubmitJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StringServiceServer).SubmitJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.StringService/SubmitJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StringServiceServer).SubmitJob(ctx, req.(*SubmitJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StringService_GetJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StringServiceServer).GetJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.StringService/GetJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StringServiceServer).GetJob(ctx, req.(*JobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StringService_CancelJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StringServiceServer).CancelJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.StringService/CancelJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StringServiceServer).CancelJob(ctx, req.(*JobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StringService_GetJobDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StringServiceServer).GetJobDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.StringService/GetJobDeliveries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StringServiceServer).GetJobDeliveries(ctx, req.(*JobRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -453,15 +4476,118 @@ var _StringService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Uppercase",
 			Handler:    _StringService_Uppercase_Handler,
 		},
+		{
+			MethodName: "Lowercase",
+			Handler:    _StringService_Lowercase_Handler,
+		},
+		{
+			MethodName: "TitleCase",
+			Handler:    _StringService_TitleCase_Handler,
+		},
 		{
 			MethodName: "Count",
 			Handler:    _StringService_Count_Handler,
 		},
+		{
+			MethodName: "Normalize",
+			Handler:    _StringService_Normalize_Handler,
+		},
+		{
+			MethodName: "Analyze",
+			Handler:    _StringService_Analyze_Handler,
+		},
+		{
+			MethodName: "Batch",
+			Handler:    _StringService_Batch_Handler,
+		},
+		{
+			MethodName: "Pipeline",
+			Handler:    _StringService_Pipeline_Handler,
+		},
+		{
+			MethodName: "Replace",
+			Handler:    _StringService_Replace_Handler,
+		},
+		{
+			MethodName: "Extract",
+			Handler:    _StringService_Extract_Handler,
+		},
+		{
+			MethodName: "Encode",
+			Handler:    _StringService_Encode_Handler,
+		},
+		{
+			MethodName: "Decode",
+			Handler:    _StringService_Decode_Handler,
+		},
+		{
+			MethodName: "Hash",
+			Handler:    _StringService_Hash_Handler,
+		},
+		{
+			MethodName: "Compare",
+			Handler:    _StringService_Compare_Handler,
+		},
+		{
+			MethodName: "Diff",
+			Handler:    _StringService_Diff_Handler,
+		},
+		{
+			MethodName: "Slugify",
+			Handler:    _StringService_Slugify_Handler,
+		},
+		{
+			MethodName: "Tokenize",
+			Handler:    _StringService_Tokenize_Handler,
+		},
+		{
+			MethodName: "Render",
+			Handler:    _StringService_Render_Handler,
+		},
+		{
+			MethodName: "Redact",
+			Handler:    _StringService_Redact_Handler,
+		},
+		{
+			MethodName: "DetectLanguage",
+			Handler:    _StringService_DetectLanguage_Handler,
+		},
+		{
+			MethodName: "Truncate",
+			Handler:    _StringService_Truncate_Handler,
+		},
+		{
+			MethodName: "Wrap",
+			Handler:    _StringService_Wrap_Handler,
+		},
+		{
+			MethodName: "SubmitJob",
+			Handler:    _StringService_SubmitJob_Handler,
+		},
+		{
+			MethodName: "GetJob",
+			Handler:    _StringService_GetJob_Handler,
+		},
+		{
+			MethodName: "CancelJob",
+			Handler:    _StringService_CancelJob_Handler,
+		},
+		{
+			MethodName: "GetJobDeliveries",
+			Handler:    _StringService_GetJobDeliveries_Handler,
+		},
 		{
 			MethodName: "Auth",
 			Handler:    _StringService_Auth_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Transform",
+			Handler:       _StringService_Transform_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
 	Metadata: "stringsvc.proto",
 }
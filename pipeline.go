@@ -0,0 +1,209 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var ErrUnknownParam = errors.New("unknown parameter")
+
+// PipelineStep is a named operation with its parameters.
+type PipelineStep struct {
+	Op     string            `json:"op"`
+	Params map[string]string `json:"params,omitempty"`
+}
+
+// PipelineResult holds the final output and, when requested, the output of every step.
+type PipelineResult struct {
+	Output       string
+	Intermediate []string
+}
+
+// PipelineError reports the step a pipeline failed at.
+type PipelineError struct {
+	Step int
+	Op   string
+	Err  error
+}
+
+func (e PipelineError) Error() string {
+	return fmt.Sprintf("step %d (%s): %s", e.Step, e.Op, e.Err)
+}
+
+// pipelineOp is an operation available to pipelines. Params lists the
+// accepted parameter names; check, if set, validates their values up front.
+type pipelineOp struct {
+	params []string
+	check  func(params map[string]string) error
+	apply  func(svc StringService, s string, params map[string]string) (string, error)
+}
+
+var pipelineOps = map[string]pipelineOp{}
+
+// registerPipelineOp makes an operation available to pipelines; transports
+// only see operation names, so new operations need no transport changes.
+func registerPipelineOp(name string, op pipelineOp) {
+	if _, dup := pipelineOps[name]; dup {
+		panic("pipeline: operation registered twice: " + name)
+	}
+	pipelineOps[name] = op
+}
+
+func pipelineOpNames() []string {
+	names := make([]string, 0, len(pipelineOps))
+	for name := range pipelineOps {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func checkPipelineStep(step PipelineStep) error {
+	op, ok := pipelineOps[step.Op]
+	if !ok {
+		return ErrUnknownOp
+	}
+	for name := range step.Params {
+		known := false
+		for _, p := range op.params {
+			known = known || p == name
+		}
+		if !known {
+			return fmt.Errorf("%w %q", ErrUnknownParam, name)
+		}
+	}
+	if op.check != nil {
+		return op.check(step.Params)
+	}
+	return nil
+}
+
+func validatePipeline(steps []PipelineStep) error {
+	for i, step := range steps {
+		if err := checkPipelineStep(step); err != nil {
+			return PipelineError{i, step.Op, err}
+		}
+	}
+	return nil
+}
+
+// runPipeline applies steps to s in order, giving up between steps once ctx
+// is done.
+func runPipeline(ctx context.Context, svc StringService, s string, steps []PipelineStep, intermediate bool) (PipelineResult, error) {
+	if err := validatePipeline(steps); err != nil {
+		return PipelineResult{}, err
+	}
+
+	var result PipelineResult
+	for i, step := range steps {
+		if err := ctx.Err(); err != nil {
+			return PipelineResult{}, err
+		}
+		out, err := pipelineOps[step.Op].apply(svc, s, step.Params)
+		if err != nil {
+			return PipelineResult{}, PipelineError{i, step.Op, err}
+		}
+		s = out
+		if intermediate {
+			result.Intermediate = append(result.Intermediate, s)
+		}
+	}
+	result.Output = s
+
+	return result, nil
+}
+
+// Built-in operations
+
+func checkLocaleParam(params map[string]string) error {
+	_, err := parseLocale(params["locale"])
+	return err
+}
+
+func boolParam(params map[string]string, name string) (bool, error) {
+	v, ok := params[name]
+	if !ok || v == "" {
+		return false, nil
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return false, fmt.Errorf("%s: %q is not a boolean", name, v)
+	}
+	return b, nil
+}
+
+func intParam(params map[string]string, name string) (int, error) {
+	v, ok := params[name]
+	if !ok || v == "" {
+		return 0, nil
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %q is not an integer", name, v)
+	}
+	return n, nil
+}
+
+func normalizeParams(params map[string]string) (NormalizeOptions, error) {
+	opts := NormalizeOptions{Form: params["form"]}
+	var err error
+	if opts.CaseFold, err = boolParam(params, "case_fold"); err != nil {
+		return opts, err
+	}
+	if opts.StripDiacritics, err = boolParam(params, "strip_diacritics"); err != nil {
+		return opts, err
+	}
+	if _, ok := normalizationForms[opts.Form]; opts.Form != "" && !ok {
+		return opts, ErrUnknownForm
+	}
+	return opts, nil
+}
+
+// casingOp skips empty input, which a previous step such as trim may produce.
+func casingOp(apply func(svc StringService, s string, locale string) (string, error)) pipelineOp {
+	return pipelineOp{
+		params: []string{"locale"},
+		check:  checkLocaleParam,
+		apply: func(svc StringService, s string, params map[string]string) (string, error) {
+			if s == "" {
+				return "", nil
+			}
+			return apply(svc, s, params["locale"])
+		},
+	}
+}
+
+func init() {
+	registerPipelineOp("trim", pipelineOp{
+		params: []string{"cutset"},
+		apply: func(_ StringService, s string, params map[string]string) (string, error) {
+			if cutset, ok := params["cutset"]; ok {
+				return strings.Trim(s, cutset), nil
+			}
+			return strings.TrimSpace(s), nil
+		},
+	})
+
+	registerPipelineOp(OpUppercase, casingOp(StringService.Uppercase))
+	registerPipelineOp(OpLowercase, casingOp(StringService.Lowercase))
+	registerPipelineOp(OpTitleCase, casingOp(StringService.TitleCase))
+
+	registerPipelineOp("normalize", pipelineOp{
+		params: []string{"form", "case_fold", "strip_diacritics"},
+		check: func(params map[string]string) error {
+			_, err := normalizeParams(params)
+			return err
+		},
+		apply: func(svc StringService, s string, params map[string]string) (string, error) {
+			opts, err := normalizeParams(params)
+			if err != nil {
+				return "", err
+			}
+			return svc.Normalize(s, opts)
+		},
+	})
+}
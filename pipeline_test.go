@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// normalizeCountingService counts Normalize calls.
+type normalizeCountingService struct {
+	StringService
+	calls int32
+}
+
+func (s *normalizeCountingService) Normalize(str string, opts NormalizeOptions) (string, error) {
+	atomic.AddInt32(&s.calls, 1)
+	return s.StringService.Normalize(str, opts)
+}
+
+func TestPipelineOrder(t *testing.T) {
+	steps := []PipelineStep{
+		{Op: "trim"},
+		{Op: "normalize", Params: map[string]string{"strip_diacritics": "true"}},
+		{Op: OpUppercase, Params: map[string]string{"locale": "tr"}},
+		{Op: "trim", Params: map[string]string{"cutset": "İ"}},
+	}
+
+	result, err := runPipeline(context.Background(), stringService{}, "  istanbul café ", steps, true)
+	assert.NoError(t, err)
+	assert.Equal(t, "STANBUL CAFE", result.Output)
+	assert.Equal(t, []string{"istanbul café", "istanbul cafe", "İSTANBUL CAFE", "STANBUL CAFE"}, result.Intermediate)
+
+	// Reordering the steps changes the result.
+	steps[2], steps[3] = steps[3], steps[2]
+	result, err = runPipeline(context.Background(), stringService{}, "  istanbul café ", steps, false)
+	assert.NoError(t, err)
+	assert.Equal(t, "İSTANBUL CAFE", result.Output)
+	assert.Nil(t, result.Intermediate)
+}
+
+func TestPipelineValidatesBeforeRunning(t *testing.T) {
+	svc := &normalizeCountingService{StringService: stringService{}}
+
+	for _, tc := range []struct {
+		steps []PipelineStep
+		want  string
+	}{
+		{[]PipelineStep{{Op: "normalize"}, {Op: "reverse"}}, "step 1 (reverse): unknown operation"},
+		{[]PipelineStep{{Op: "normalize"}, {Op: "trim", Params: map[string]string{"chars": "x"}}}, `step 1 (trim): unknown parameter "chars"`},
+		{[]PipelineStep{{Op: "normalize"}, {Op: OpLowercase, Params: map[string]string{"locale": "??"}}}, "step 1 (lowercase): invalid locale"},
+		{[]PipelineStep{{Op: "normalize", Params: map[string]string{"case_fold": "maybe"}}}, `step 0 (normalize): case_fold: "maybe" is not a boolean`},
+		{[]PipelineStep{{Op: "normalize"}, {Op: "truncate", Params: map[string]string{"max": "2", "ellipsis": "..."}}}, "step 1 (truncate): invalid request: ellipsis: ellipsis longer than max"},
+		{[]PipelineStep{{Op: "normalize"}, {Op: "truncate", Params: map[string]string{"max": "5", "unit": "words"}}}, "step 1 (truncate): invalid request: unit: must be one of bytes, runes, graphemes, width"},
+		{[]PipelineStep{{Op: "normalize"}, {Op: "wrap", Params: map[string]string{"width": "0"}}}, "step 1 (wrap): invalid request: width: must be between 1 and 65536"},
+	} {
+		_, err := runPipeline(context.Background(), svc, "hello", tc.steps, false)
+		assert.EqualError(t, err, tc.want)
+	}
+	assert.Equal(t, int32(0), atomic.LoadInt32(&svc.calls), "no step runs when any is invalid")
+}
+
+func TestPipelineStepError(t *testing.T) {
+	_, err := runPipeline(context.Background(), stringService{}, "abc", []PipelineStep{
+		{Op: OpUppercase},
+		{Op: "decode", Params: map[string]string{"encoding": "hex"}},
+	}, true)
+
+	if assert.IsType(t, PipelineError{}, err) {
+		assert.Equal(t, 1, err.(PipelineError).Step)
+		assert.Equal(t, "decode", err.(PipelineError).Op)
+	}
+}
+
+func TestPipelineSkipsCasingEmptyInput(t *testing.T) {
+	result, err := runPipeline(context.Background(), stringService{}, "   ", []PipelineStep{{Op: "trim"}, {Op: OpUppercase}}, false)
+	assert.NoError(t, err)
+	assert.Equal(t, "", result.Output)
+}
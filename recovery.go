@@ -0,0 +1,104 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"runtime/debug"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/metrics"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// panicError is returned to clients in place of a recovered panic.
+// The panic value itself is only logged.
+type panicError struct {
+	requestID string
+}
+
+func (e panicError) Error() string {
+	return fmt.Sprintf("internal error (request id %s)", e.requestID)
+}
+
+// StatusCode implements httptransport.StatusCoder.
+func (e panicError) StatusCode() int {
+	return http.StatusInternalServerError
+}
+
+func (e panicError) GRPCStatus() *status.Status {
+	return status.New(codes.Internal, e.Error())
+}
+
+func logPanic(ctx context.Context, logger log.Logger, panics metrics.Counter, layer string, r interface{}) error {
+	id := requestIDFromContext(ctx)
+	panics.Add(1)
+	_ = logger.Log(
+		"panic", fmt.Sprint(r),
+		"layer", layer,
+		"request_id", id,
+		"stack", string(debug.Stack()),
+	)
+	return panicError{id}
+}
+
+// recoveryMiddleware converts panics in an endpoint into a panicError.
+func recoveryMiddleware(logger log.Logger, panics metrics.Counter) endpoint.Middleware {
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+			defer func() {
+				if r := recover(); r != nil {
+					response, err = nil, logPanic(ctx, logger, panics, "endpoint", r)
+				}
+			}()
+
+			return next(ctx, request)
+		}
+	}
+}
+
+// recoveryHTTP catches panics raised by decoders, encoders and handlers outside the endpoint.
+func recoveryHTTP(logger log.Logger, panics metrics.Counter) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer func() {
+				if rec := recover(); rec != nil {
+					if rec == http.ErrAbortHandler {
+						panic(rec)
+					}
+					encodeError(r.Context(), logPanic(r.Context(), logger, panics, "http", rec), w)
+				}
+			}()
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func recoveryUnaryInterceptor(logger log.Logger, panics metrics.Counter) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				resp, err = nil, logPanic(ctx, logger, panics, "grpc", r)
+			}
+		}()
+
+		return handler(ctx, req)
+	}
+}
+
+func recoveryStreamInterceptor(logger log.Logger, panics metrics.Counter) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		ctx := contextWithRequestID(ss.Context(), requestIDFromMetadata(ss.Context()))
+		defer func() {
+			if r := recover(); r != nil {
+				err = logPanic(ctx, logger, panics, "grpc", r)
+			}
+		}()
+
+		return handler(srv, ss)
+	}
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"context"
+	"expvar"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/fnaumov/gokit-stringsvc/pb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type panickingService struct {
+	StringService
+}
+
+func (panickingService) Uppercase(string, string) (string, error) {
+	panic("uppercase exploded")
+}
+
+func panicCount() float64 {
+	return expvar.Get("panics_total").(*expvar.Float).Value()
+}
+
+func testToken(t *testing.T) string {
+	token, err := generateToken(authConfig.key, "user1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+func TestHTTPPanicRecovery(t *testing.T) {
+	svc := panickingService{makeSvc()}
+	srv := httptest.NewServer(makeHTTPHandler(svc, makeJobs(svc)))
+	defer srv.Close()
+
+	req, _ := http.NewRequest("POST", srv.URL+"/uppercase", strings.NewReader(`{"s": "hello"}`))
+	req.Header.Set("Authorization", "Bearer "+testToken(t))
+	req.Header.Set(requestIDHeader, "http-panic")
+
+	before := panicCount()
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	assert.Equal(t, "http-panic", resp.Header.Get(requestIDHeader))
+	assert.Equal(t, before+1, panicCount())
+}
+
+func TestGRPCPanicRecovery(t *testing.T) {
+	conn, stop := dialTestGRPCServer(t, panickingService{makeSvc()})
+	defer stop()
+
+	md := metadata.Pairs("authorization", fmt.Sprintf("Bearer %s", testToken(t)), "x-request-id", "grpc-panic")
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+
+	before := panicCount()
+	_, err := pb.NewStringServiceClient(conn).Uppercase(ctx, &pb.UppercaseRequest{S: "hello"})
+
+	assert.Equal(t, codes.Internal, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "grpc-panic")
+	assert.Equal(t, before+1, panicCount())
+}
+
+func TestRecoveryMiddleware(t *testing.T) {
+	ep := recoveryMiddleware(logger, panicsTotal)(makeUppercaseEndpoint(makeSvc()))
+
+	before := panicCount()
+	_, err := ep(contextWithRequestID(context.Background(), "bad-request"), countRequest{S: "hello"})
+
+	assert.Equal(t, panicError{"bad-request"}, err)
+	assert.Equal(t, before+1, panicCount())
+}
+
+func TestMetricsRequireAuthentication(t *testing.T) {
+	srv := httptest.NewServer(makeHTTPHandler(makeSvc(), makeJobs(makeSvc())))
+	defer srv.Close()
+
+	get := func(token string) *http.Response {
+		req, _ := http.NewRequest("GET", srv.URL+"/debug/vars", nil)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		return resp
+	}
+
+	assert.Equal(t, http.StatusUnauthorized, get("").StatusCode)
+	assert.Equal(t, http.StatusUnauthorized, get("not-a-token").StatusCode)
+	assert.Equal(t, http.StatusOK, get(testToken(t)).StatusCode)
+}
@@ -0,0 +1,333 @@
+package main
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"net"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Redaction detectors and masking styles
+const (
+	PIIEmail     = "email"
+	PIIPhone     = "phone"
+	PIICard      = "card"
+	PIIIBAN      = "iban"
+	PIIIP        = "ip"
+	MaskFull     = "full"
+	MaskPartial  = "partial"
+	MaskHash     = "hash"
+	partialClear = 4
+	// maxPIIChars is the most letters and digits in a value, an IBAN's 34.
+	maxPIIChars = 34
+)
+
+var (
+	ErrUnknownDetector = errors.New("unknown detector")
+	ErrUnknownMask     = errors.New("unknown style, expected full, partial or hash")
+	ErrNoRedactKey     = errors.New("no key configured for hash masking")
+)
+
+// RedactOptions configures Redact. Detectors defaults to all of them and
+// Style to full. The hash style uses the service's redaction key, which is
+// not one of the keys Hash can use, so masks cannot be recomputed by clients.
+type RedactOptions struct {
+	Detectors []string
+	Style     string
+}
+
+// Finding is detected PII with its byte offsets in the input.
+type Finding struct {
+	Type  string `json:"type"`
+	Start int    `json:"start"`
+	End   int    `json:"end"`
+}
+
+// RedactResult holds the masked text and what was masked.
+type RedactResult struct {
+	Output   string
+	Findings []Finding
+}
+
+// detector finds candidates with re and keeps those accepted by valid.
+type detector struct {
+	re    *regexp.Regexp
+	valid func(string) bool
+}
+
+// detectors are listed by priority: a match claims its text before detectors
+// listed after it, so a card number is not also reported as a phone number.
+var (
+	detectorOrder = []string{PIIEmail, PIIIBAN, PIICard, PIIIP, PIIPhone}
+	detectors     = map[string]detector{
+		PIIEmail: {regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9\-]+(?:\.[A-Za-z0-9\-]+)*\.[A-Za-z]{2,}`), nil},
+		PIIIBAN:  {regexp.MustCompile(`\b[A-Z]{2}\d{2}(?:[A-Z0-9]{11,30}|(?: [A-Z0-9]{4}){2,7}(?: [A-Z0-9]{1,4})?)\b`), validIBAN},
+		PIICard:  {regexp.MustCompile(`\b(?:\d[ \-]?){12,18}\d\b`), validCard},
+		PIIIP:    {regexp.MustCompile(`\b(?:\d{1,3}\.){3}\d{1,3}\b|(?:[0-9A-Fa-f]{0,4}:){2,7}[0-9A-Fa-f]{0,4}`), validIP},
+		PIIPhone: {regexp.MustCompile(`\+?\d[\d ().\-]{6,}\d`), validPhone},
+	}
+)
+
+func digits(s string) string {
+	return strings.Map(func(r rune) rune {
+		if '0' <= r && r <= '9' {
+			return r
+		}
+		return -1
+	}, s)
+}
+
+// validCard checks the length and Luhn checksum of a card number.
+func validCard(s string) bool {
+	d := digits(s)
+	if len(d) < 13 || len(d) > 19 {
+		return false
+	}
+	sum := 0
+	for i := range d {
+		n := int(d[len(d)-1-i] - '0')
+		if i%2 == 1 {
+			if n *= 2; n > 9 {
+				n -= 9
+			}
+		}
+		sum += n
+	}
+	return sum%10 == 0
+}
+
+// validIBAN checks the ISO 13616 mod-97 checksum.
+func validIBAN(s string) bool {
+	s = strings.Replace(s, " ", "", -1)
+	if len(s) < 15 || len(s) > 34 {
+		return false
+	}
+	var numeric strings.Builder
+	for _, r := range s[4:] + s[:4] {
+		if r >= 'A' && r <= 'Z' {
+			numeric.WriteString(strconv.Itoa(int(r - 'A' + 10)))
+		} else {
+			numeric.WriteRune(r)
+		}
+	}
+	n, ok := new(big.Int).SetString(numeric.String(), 10)
+	return ok && new(big.Int).Mod(n, big.NewInt(97)).Int64() == 1
+}
+
+func validIP(s string) bool {
+	ip := net.ParseIP(s)
+	return ip != nil && !ip.IsUnspecified()
+}
+
+// validPhone accepts 9 to 15 digits, the E.164 maximum; fewer are too easily
+// confused with dates and other numbers.
+func validPhone(s string) bool {
+	n := len(digits(s))
+	return n >= 9 && n <= 15
+}
+
+// alnumGroups returns the offsets of the runs of letters and digits in s.
+func alnumGroups(s string) [][2]int {
+	var groups [][2]int
+	start := -1
+	for i, r := range s + " " {
+		alnum := unicode.IsLetter(r) || unicode.IsDigit(r)
+		switch {
+		case alnum && start < 0:
+			start = i
+		case !alnum && start >= 0:
+			groups = append(groups, [2]int{start, i})
+			start = -1
+		}
+	}
+	return groups
+}
+
+// validSpans finds the values inside s[start:end], a match that failed
+// validation as a whole, such as two phone numbers in a row or a card number
+// followed by its expiry date. Its groups of letters and digits must split
+// into valid values, longest first; trailing groups may only be left out when
+// set apart by a separator the last value does not use itself.
+func validSpans(s string, start int, end int, valid func(string) bool) [][2]int {
+	groups := alnumGroups(s[start:end])
+	n := len(groups)
+	sep := func(k int) string {
+		return s[start+groups[k][1] : start+groups[k+1][0]]
+	}
+
+	// spans[i] splits groups[i:], ok[i] reporting whether they can be.
+	spans := make([][][2]int, n+1)
+	ok := make([]bool, n+1)
+	ok[n] = true
+	for i := n - 1; i >= 0; i-- {
+		last, chars := i, 0
+		for ; last < n && chars+groups[last][1]-groups[last][0] <= maxPIIChars; last++ {
+			chars += groups[last][1] - groups[last][0]
+		}
+		for j := last - 1; j >= i; j-- {
+			span := [2]int{start + groups[i][0], start + groups[j][1]}
+			if !valid(s[span[0]:span[1]]) {
+				continue
+			}
+			if ok[j+1] {
+				spans[i], ok[i] = append([][2]int{span}, spans[j+1]...), true
+				break
+			}
+			apart := true
+			for k := i; k < j; k++ {
+				apart = apart && sep(k) != sep(j)
+			}
+			if apart {
+				spans[i], ok[i] = [][2]int{span}, true
+				break
+			}
+		}
+	}
+	return spans[0]
+}
+
+func checkRedactOptions(opts RedactOptions) error {
+	for _, d := range opts.Detectors {
+		if _, ok := detectors[d]; !ok {
+			return ErrUnknownDetector
+		}
+	}
+	switch opts.Style {
+	case "", MaskFull, MaskPartial, MaskHash:
+		return nil
+	}
+	return ErrUnknownMask
+}
+
+// findPII returns non-overlapping findings sorted by offset.
+func findPII(s string, enabled []string) []Finding {
+	if len(enabled) == 0 {
+		enabled = detectorOrder
+	}
+	on := map[string]bool{}
+	for _, d := range enabled {
+		on[d] = true
+	}
+
+	var findings []Finding
+	claimed := make([]bool, len(s))
+	for _, name := range detectorOrder {
+		if !on[name] {
+			continue
+		}
+		det := detectors[name]
+		for _, loc := range det.re.FindAllStringIndex(s, -1) {
+			spans := [][2]int{{loc[0], loc[1]}}
+			if det.valid != nil && !det.valid(s[loc[0]:loc[1]]) {
+				spans = validSpans(s, loc[0], loc[1], det.valid)
+			}
+		spans:
+			for _, span := range spans {
+				for i := span[0]; i < span[1]; i++ {
+					if claimed[i] {
+						continue spans
+					}
+				}
+				for i := span[0]; i < span[1]; i++ {
+					claimed[i] = true
+				}
+				findings = append(findings, Finding{name, span[0], span[1]})
+			}
+		}
+	}
+
+	sort.Slice(findings, func(i, j int) bool { return findings[i].Start < findings[j].Start })
+	return findings
+}
+
+// maskPartial keeps the first character and domain of an email address, or
+// the last four letters and digits of anything else, and separators.
+func maskPartial(kind string, v string) string {
+	if kind == PIIEmail {
+		at := strings.LastIndex(v, "@")
+		_, size := utf8.DecodeRuneInString(v)
+		return v[:size] + strings.Repeat("*", utf8.RuneCountInString(v[size:at])) + v[at:]
+	}
+	clear := 0
+	out := []rune(v)
+	for i := len(out) - 1; i >= 0; i-- {
+		if !unicode.IsLetter(out[i]) && !unicode.IsDigit(out[i]) {
+			continue
+		}
+		if clear < partialClear {
+			clear++
+			continue
+		}
+		out[i] = '*'
+	}
+	return string(out)
+}
+
+func mask(kind string, v string, style string, key []byte) (string, error) {
+	switch style {
+	case MaskPartial:
+		return maskPartial(kind, v), nil
+	case MaskHash:
+		if len(key) == 0 {
+			return "", ErrNoRedactKey
+		}
+		mac := hmac.New(sha256.New, key)
+		mac.Write([]byte(v))
+		return "[" + kind + ":" + hex.EncodeToString(mac.Sum(nil))[:16] + "]", nil
+	}
+	return strings.Repeat("*", utf8.RuneCountInString(v)), nil
+}
+
+func redactString(s string, opts RedactOptions, key []byte) (RedactResult, error) {
+	if err := checkRedactOptions(opts); err != nil {
+		return RedactResult{}, err
+	}
+
+	findings := findPII(s, opts.Detectors)
+	var out strings.Builder
+	last := 0
+	for _, f := range findings {
+		masked, err := mask(f.Type, s[f.Start:f.End], opts.Style, key)
+		if err != nil {
+			return RedactResult{}, err
+		}
+		out.WriteString(s[last:f.Start])
+		out.WriteString(masked)
+		last = f.End
+	}
+	out.WriteString(s[last:])
+
+	if findings == nil {
+		findings = []Finding{}
+	}
+	return RedactResult{out.String(), findings}, nil
+}
+
+func redactParams(params map[string]string) RedactOptions {
+	opts := RedactOptions{Style: params["style"]}
+	if d := params["detectors"]; d != "" {
+		opts.Detectors = strings.Split(d, ",")
+	}
+	return opts
+}
+
+func init() {
+	registerPipelineOp("redact", pipelineOp{
+		params: []string{"detectors", "style"},
+		check: func(params map[string]string) error {
+			return checkRedactOptions(redactParams(params))
+		},
+		apply: func(svc StringService, s string, params map[string]string) (string, error) {
+			result, err := svc.Redact(s, redactParams(params))
+			return result.Output, err
+		},
+	})
+}
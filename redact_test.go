@@ -0,0 +1,69 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/go-kit/kit/log"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedact(t *testing.T) {
+	key := []byte("k")
+	s := "Mail jane.doe@example.com or call +1 (555) 123-4567 from 192.168.0.10, " +
+		"card 4111 1111 1111 1111, IBAN DE89 3704 0044 0532 0130 00, order 4111 1111 1111 1112 on 2024-01-15."
+
+	result, err := redactString(s, RedactOptions{}, key)
+	assert.NoError(t, err)
+	assert.Equal(t, "Mail ******************** or call ***************** from ************, "+
+		"card *******************, IBAN ***************************, order 4111 1111 1111 1112 on 2024-01-15.", result.Output)
+	assert.Equal(t, []Finding{
+		{PIIEmail, 5, 25}, {PIIPhone, 34, 51}, {PIIIP, 57, 69}, {PIICard, 76, 95}, {PIIIBAN, 102, 129},
+	}, result.Findings)
+
+	result, _ = redactString(s[:51], RedactOptions{Style: MaskPartial, Detectors: []string{PIIEmail, PIIPhone}}, key)
+	assert.Equal(t, "Mail j*******@example.com or call +* (***) ***-4567", result.Output)
+
+	a, _ := redactString("a@example.com", RedactOptions{Style: MaskHash}, key)
+	b, _ := redactString("a@example.com", RedactOptions{Style: MaskHash}, key)
+	assert.Equal(t, a.Output, b.Output)
+	assert.Len(t, a.Output, len("[email:0123456789abcdef]"))
+
+	_, err = redactString("a@example.com", RedactOptions{Style: MaskHash}, nil)
+	assert.Equal(t, ErrNoRedactKey, err)
+}
+
+func TestRedactSplitsInvalidMatches(t *testing.T) {
+	// The expiry date joins the card number in one match failing Luhn.
+	result, err := redactString("card 4111111111111111 05/25 thanks", RedactOptions{}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "card **************** 05/25 thanks", result.Output)
+	assert.Equal(t, []Finding{{PIICard, 5, 21}}, result.Findings)
+
+	// Two numbers in a row make one run of 20 digits, too long for a phone.
+	result, err = redactString("Phones: 555 123 4567 555 987 6543", RedactOptions{}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "Phones: ************ ************", result.Output)
+	assert.Equal(t, []Finding{{PIIPhone, 8, 20}, {PIIPhone, 21, 33}}, result.Findings)
+}
+
+func TestRedactKeyIsNotExposedByHash(t *testing.T) {
+	svc := stringService{hmacKeys: map[string][]byte{"default": []byte("d")}, redactKey: []byte("k")}
+
+	_, err := svc.Hash("a@example.com", HashOptions{Algorithm: "sha256", Key: "redact"})
+	assert.Equal(t, ErrUnknownKey, err)
+}
+
+func TestPipelineLogOmitsRedactedInput(t *testing.T) {
+	var buf bytes.Buffer
+	svc := loggingMiddleware{authConfig, log.NewLogfmtLogger(&buf), stringService{}}
+
+	_, err := svc.Pipeline(context.Background(), "jane@example.com", []PipelineStep{{Op: "redact"}}, false)
+	assert.NoError(t, err)
+	assert.NotContains(t, buf.String(), "jane@example.com")
+
+	buf.Reset()
+	_, _ = svc.Pipeline(context.Background(), "hello", []PipelineStep{{Op: "uppercase"}}, false)
+	assert.Contains(t, buf.String(), "input=hello")
+}
@@ -0,0 +1,272 @@
+package main
+
+import (
+	"container/list"
+	"errors"
+	"regexp"
+	"regexp/syntax"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	ErrPatternTooLong    = errors.New("pattern too long")
+	ErrPatternTooComplex = errors.New("pattern too complex")
+	ErrInvalidFlags      = errors.New("invalid flags, expected a combination of i, m, s and U")
+	ErrRegexTimeout      = errors.New("regular expression took too long")
+	ErrReplaceTooLarge   = errors.New("replaced string too large")
+)
+
+// Limits protecting the service from hostile patterns.
+const (
+	maxPatternLength  = 1024
+	maxPatternInsts   = 5000
+	regexCacheSize    = 256
+	defaultMatchLimit = 1000
+)
+
+// regexTimeout bounds how long a caller waits for a match. RE2 runs in linear
+// time so executions always finish, and replacements stop expanding once past
+// their deadline; regexSlots bounds how many may run at once.
+var (
+	regexTimeout = 250 * time.Millisecond
+	regexSlots   = make(chan struct{}, runtime.NumCPU())
+)
+
+// ReplaceOptions configures Replace. Replacement may reference submatches as $1 or ${name}.
+type ReplaceOptions struct {
+	Pattern     string
+	Replacement string
+	Flags       string
+}
+
+// ExtractOptions configures Extract. Limit caps the number of matches, 0 meaning the default.
+type ExtractOptions struct {
+	Pattern string
+	Flags   string
+	Limit   int
+}
+
+// RegexMatch is a match with its byte offsets and submatch groups.
+type RegexMatch struct {
+	Text   string            `json:"text"`
+	Start  int               `json:"start"`
+	End    int               `json:"end"`
+	Groups []string          `json:"groups,omitempty"`
+	Named  map[string]string `json:"named,omitempty"`
+}
+
+// regexCache is a small LRU of compiled patterns keyed by flags and pattern.
+type regexCache struct {
+	mtx     sync.Mutex
+	size    int
+	order   *list.List
+	entries map[string]*list.Element
+}
+
+type regexCacheEntry struct {
+	key string
+	re  *regexp.Regexp
+}
+
+var compiledPatterns = &regexCache{
+	size:    regexCacheSize,
+	order:   list.New(),
+	entries: map[string]*list.Element{},
+}
+
+func (c *regexCache) get(pattern string, flags string) (*regexp.Regexp, error) {
+	key := flags + "/" + pattern
+
+	c.mtx.Lock()
+	if el, ok := c.entries[key]; ok {
+		c.order.MoveToFront(el)
+		c.mtx.Unlock()
+		return el.Value.(regexCacheEntry).re, nil
+	}
+	c.mtx.Unlock()
+
+	re, err := compilePattern(pattern, flags)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if _, ok := c.entries[key]; !ok {
+		c.entries[key] = c.order.PushFront(regexCacheEntry{key, re})
+		if c.order.Len() > c.size {
+			oldest := c.order.Back()
+			c.order.Remove(oldest)
+			delete(c.entries, oldest.Value.(regexCacheEntry).key)
+		}
+	}
+	return re, nil
+}
+
+func checkFlags(flags string) error {
+	for _, f := range flags {
+		if !strings.ContainsRune("imsU", f) {
+			return ErrInvalidFlags
+		}
+	}
+	return nil
+}
+
+// compilePattern checks the length and compiled program size of an RE2
+// pattern before compiling it.
+func compilePattern(pattern string, flags string) (*regexp.Regexp, error) {
+	if len(pattern) > maxPatternLength {
+		return nil, ErrPatternTooLong
+	}
+	if err := checkFlags(flags); err != nil {
+		return nil, err
+	}
+	if flags != "" {
+		pattern = "(?" + flags + ")" + pattern
+	}
+
+	parsed, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return nil, err
+	}
+	prog, err := syntax.Compile(parsed.Simplify())
+	if err != nil {
+		return nil, err
+	}
+	if len(prog.Inst) > maxPatternInsts {
+		return nil, ErrPatternTooComplex
+	}
+
+	return regexp.Compile(pattern)
+}
+
+// runRegex runs fn on one of the regexSlots, giving up after regexTimeout.
+// fn is passed the deadline so that it can stop early once abandoned.
+func runRegex(fn func(deadline time.Time)) error {
+	deadline := time.Now().Add(regexTimeout)
+	timer := time.NewTimer(regexTimeout)
+	defer timer.Stop()
+
+	select {
+	case regexSlots <- struct{}{}:
+	case <-timer.C:
+		return ErrRegexTimeout
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer func() { <-regexSlots }()
+		fn(deadline)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-timer.C:
+		return ErrRegexTimeout
+	}
+}
+
+func replaceString(s string, opts ReplaceOptions) (string, error) {
+	re, err := compiledPatterns.get(opts.Pattern, opts.Flags)
+	if err != nil {
+		return "", err
+	}
+
+	var out []byte
+	var expandErr error
+	err = runRegex(func(deadline time.Time) {
+		out, expandErr = expandMatches(re, s, opts.Replacement, limits.maxStringBytes, deadline)
+	})
+	if err != nil {
+		return "", err
+	}
+	if expandErr != nil {
+		return "", expandErr
+	}
+	return string(out), nil
+}
+
+// expandMatches replaces the matches of re in s like ReplaceAllString,
+// failing as soon as the result exceeds max bytes or deadline passes.
+func expandMatches(re *regexp.Regexp, s string, replacement string, max int, deadline time.Time) ([]byte, error) {
+	var out []byte
+	last := 0
+	for i, m := range re.FindAllStringSubmatchIndex(s, -1) {
+		out = append(out, s[last:m[0]]...)
+		out = re.ExpandString(out, replacement, s, m)
+		last = m[1]
+		if len(out) > max {
+			return nil, ErrReplaceTooLarge
+		}
+		if i%1024 == 1023 && time.Now().After(deadline) {
+			return nil, ErrRegexTimeout
+		}
+	}
+	out = append(out, s[last:]...)
+	if len(out) > max {
+		return nil, ErrReplaceTooLarge
+	}
+	return out, nil
+}
+
+func extractString(s string, opts ExtractOptions) ([]RegexMatch, error) {
+	re, err := compiledPatterns.get(opts.Pattern, opts.Flags)
+	if err != nil {
+		return nil, err
+	}
+	limit := opts.Limit
+	if limit <= 0 || limit > defaultMatchLimit {
+		limit = defaultMatchLimit
+	}
+
+	var indexes [][]int
+	if err := runRegex(func(time.Time) { indexes = re.FindAllStringSubmatchIndex(s, limit) }); err != nil {
+		return nil, err
+	}
+
+	names := re.SubexpNames()
+	matches := make([]RegexMatch, len(indexes))
+	for i, loc := range indexes {
+		m := RegexMatch{Text: s[loc[0]:loc[1]], Start: loc[0], End: loc[1]}
+		for g := 1; g < len(loc)/2; g++ {
+			var group string
+			if loc[2*g] >= 0 {
+				group = s[loc[2*g]:loc[2*g+1]]
+			}
+			m.Groups = append(m.Groups, group)
+			if names[g] != "" {
+				if m.Named == nil {
+					m.Named = map[string]string{}
+				}
+				m.Named[names[g]] = group
+			}
+		}
+		matches[i] = m
+	}
+	return matches, nil
+}
+
+func init() {
+	registerPipelineOp("replace", pipelineOp{
+		params: []string{"pattern", "replacement", "flags"},
+		check: func(params map[string]string) error {
+			return replaceRequest{
+				Pattern:     params["pattern"],
+				Replacement: params["replacement"],
+				Flags:       params["flags"],
+			}.validate()
+		},
+		apply: func(svc StringService, s string, params map[string]string) (string, error) {
+			return svc.Replace(s, ReplaceOptions{
+				Pattern:     params["pattern"],
+				Replacement: params["replacement"],
+				Flags:       params["flags"],
+			})
+		},
+	})
+}
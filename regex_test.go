@@ -0,0 +1,108 @@
+package main
+
+import (
+	"container/list"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplace(t *testing.T) {
+	for _, tc := range []struct {
+		s    string
+		opts ReplaceOptions
+		want string
+		err  error
+	}{
+		{"2024-01-31", ReplaceOptions{Pattern: `(\d+)-(\d+)-(\d+)`, Replacement: "$3/$2/$1"}, "31/01/2024", nil},
+		{"John Smith", ReplaceOptions{Pattern: `(?P<first>\w+) (?P<last>\w+)`, Replacement: "${last}, ${first}"}, "Smith, John", nil},
+		{"Hello hello", ReplaceOptions{Pattern: "hello", Replacement: "bye", Flags: "i"}, "bye bye", nil},
+		{"baaac", ReplaceOptions{Pattern: "a*", Replacement: "-"}, "-b-c-", nil},
+		{"a.b", ReplaceOptions{Pattern: `\.`, Replacement: "$$"}, "a$b", nil},
+		{"abc", ReplaceOptions{Pattern: "a", Flags: "x"}, "", ErrInvalidFlags},
+		{"abc", ReplaceOptions{Pattern: strings.Repeat("a", maxPatternLength+1)}, "", ErrPatternTooLong},
+		{"abc", ReplaceOptions{Pattern: "a{1000}b{1000}c{1000}d{1000}e{1000}f{1000}"}, "", ErrPatternTooComplex},
+	} {
+		got, err := replaceString(tc.s, tc.opts)
+		assert.Equal(t, tc.err, err, tc.opts.Pattern)
+		assert.Equal(t, tc.want, got, tc.opts.Pattern)
+	}
+
+	_, err := replaceString("abc", ReplaceOptions{Pattern: "(a"})
+	assert.EqualError(t, err, "error parsing regexp: missing closing ): `(a`")
+}
+
+func TestReplaceMatchesReplaceAllString(t *testing.T) {
+	s := "the quick brown fox, the lazy dog"
+	for _, pattern := range []string{`\b`, `o*`, `(t)(h)?e`, `^|$`, `x`} {
+		re, err := compiledPatterns.get(pattern, "")
+		if assert.NoError(t, err) {
+			got, err := replaceString(s, ReplaceOptions{Pattern: pattern, Replacement: "<$1>"})
+			assert.NoError(t, err)
+			assert.Equal(t, re.ReplaceAllString(s, "<$1>"), got, pattern)
+		}
+	}
+}
+
+func TestReplaceOutputLimit(t *testing.T) {
+	s := strings.Repeat("a", 1024)
+	_, err := replaceString(s, ReplaceOptions{Pattern: "a", Replacement: strings.Repeat("b", limits.maxStringBytes/1024+1)})
+	assert.Equal(t, ErrReplaceTooLarge, err)
+
+	got, err := replaceString(s, ReplaceOptions{Pattern: "a", Replacement: strings.Repeat("b", limits.maxStringBytes/1024)})
+	assert.NoError(t, err)
+	assert.Len(t, got, limits.maxStringBytes)
+}
+
+func TestExtract(t *testing.T) {
+	matches, err := extractString("a1 b22 c333", ExtractOptions{Pattern: `(?P<letter>[a-z])(\d+)`, Limit: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, []RegexMatch{
+		{Text: "a1", Start: 0, End: 2, Groups: []string{"a", "1"}, Named: map[string]string{"letter": "a"}},
+		{Text: "b22", Start: 3, End: 6, Groups: []string{"b", "22"}, Named: map[string]string{"letter": "b"}},
+	}, matches)
+
+	_, err = extractString("abc", ExtractOptions{Pattern: "[a-"})
+	assert.Error(t, err)
+}
+
+func TestRegexCache(t *testing.T) {
+	cache := &regexCache{size: 2, order: list.New(), entries: map[string]*list.Element{}}
+
+	a, err := cache.get("a+", "")
+	assert.NoError(t, err)
+	again, _ := cache.get("a+", "")
+	assert.True(t, a == again, "cached pattern is reused")
+	insensitive, _ := cache.get("a+", "i")
+	assert.False(t, a == insensitive, "flags are part of the key")
+
+	// b+ evicts the least recently used entry, a+ without flags.
+	_, _ = cache.get("b+", "")
+	assert.Equal(t, 2, cache.order.Len())
+	assert.NotContains(t, cache.entries, "/a+")
+	assert.Contains(t, cache.entries, "i/a+")
+
+	_, err = cache.get("[", "")
+	assert.Error(t, err)
+	assert.Equal(t, 2, cache.order.Len(), "invalid patterns are not cached")
+}
+
+func TestRegexTimeout(t *testing.T) {
+	defer func(timeout time.Duration) { regexTimeout = timeout }(regexTimeout)
+	regexTimeout = 10 * time.Millisecond
+
+	release := make(chan struct{})
+	err := runRegex(func(time.Time) { <-release })
+	assert.Equal(t, ErrRegexTimeout, err)
+	close(release)
+
+	// The slot is returned once the abandoned execution finishes.
+	assert.NoError(t, runRegex(func(time.Time) {}))
+
+	// Expansion stops once past its deadline.
+	_, err = expandMatches(regexp.MustCompile("a"), strings.Repeat("a", 4096), "b", limits.maxStringBytes, time.Now().Add(-time.Second))
+	assert.Equal(t, ErrRegexTimeout, err)
+}
@@ -0,0 +1,218 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"runtime"
+	"strings"
+	"text/template"
+	"text/template/parse"
+	"time"
+)
+
+var (
+	ErrTemplateTooLong   = errors.New("template too long")
+	ErrRenderTimeout     = errors.New("template took too long to render")
+	ErrRenderOutputLimit = errors.New("rendered output too large")
+	ErrRenderSteps       = errors.New("template ran too many range iterations")
+)
+
+// Limits applied to templates. Execution cannot be interrupted from outside,
+// so templates may not define or call templates, may only range over fields
+// and variables, and may not nest ranges deeper than maxRangeDepth. Every
+// range iteration counts against maxRenderSteps and checks the deadline.
+const (
+	maxTemplateLength = 16 << 10
+	maxRenderOutput   = 256 << 10
+	maxRangeDepth     = 2
+	maxRenderSteps    = 100000
+	renderStepFunc    = "renderStep"
+)
+
+var (
+	renderTimeout = 250 * time.Millisecond
+	renderSlots   = make(chan struct{}, runtime.NumCPU())
+)
+
+// renderFuncs are the functions available to templates besides the
+// text/template builtins, of which call is disabled.
+func renderFuncs(svc StringService) template.FuncMap {
+	return template.FuncMap{
+		"uppercase": func(s string) (string, error) { return svc.Uppercase(s, "") },
+		"lowercase": func(s string) (string, error) { return svc.Lowercase(s, "") },
+		"titlecase": func(s string) (string, error) { return svc.TitleCase(s, "") },
+		"trim":      strings.TrimSpace,
+		"normalize": func(s string) (string, error) { return svc.Normalize(s, NormalizeOptions{}) },
+		"slugify":   func(s string) (string, error) { return svc.Slugify(s, SlugOptions{}) },
+		"encode":    func(encoding string, s string) (string, error) { return svc.Encode(s, encoding) },
+		"hash": func(algorithm string, s string) (string, error) {
+			return svc.Hash(s, HashOptions{Algorithm: algorithm})
+		},
+		"call": func(...interface{}) (string, error) { return "", errors.New("call is not allowed") },
+		// renderStep is replaced by renderBudget.step for each execution.
+		renderStepFunc: func() (string, error) { return "", nil },
+	}
+}
+
+// renderStepNode is the {{renderStep}} action prepended to range bodies.
+var renderStepNode = func() parse.Node {
+	trees, err := parse.Parse("step", "{{"+renderStepFunc+"}}", "", "", renderFuncs(nil))
+	if err != nil {
+		panic(err)
+	}
+	return trees["step"].Root.Nodes[0]
+}()
+
+// renderBudget stops an execution after steps range iterations or once its
+// deadline has passed.
+type renderBudget struct {
+	steps    int
+	deadline time.Time
+}
+
+func (b *renderBudget) step() (string, error) {
+	b.steps--
+	if b.steps < 0 {
+		return "", ErrRenderSteps
+	}
+	if time.Now().After(b.deadline) {
+		return "", ErrRenderTimeout
+	}
+	return "", nil
+}
+
+// parseTemplate parses text and checks it against the template restrictions.
+func parseTemplate(svc StringService, text string) (*template.Template, error) {
+	if len(text) > maxTemplateLength {
+		return nil, ErrTemplateTooLong
+	}
+	tmpl, err := template.New("render").Funcs(renderFuncs(svc)).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, err
+	}
+	if len(tmpl.Templates()) > 1 {
+		return nil, errors.New("template: define and block are not allowed")
+	}
+	if tmpl.Tree == nil {
+		return tmpl, nil
+	}
+	if err := checkTemplateNode(tmpl.Tree, tmpl.Tree.Root, 0); err != nil {
+		return nil, err
+	}
+	return tmpl, nil
+}
+
+// checkTemplateNode checks node against the template restrictions and makes
+// every range in it call renderStep on each iteration.
+func checkTemplateNode(tree *parse.Tree, node parse.Node, ranges int) error {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return nil
+		}
+		for _, child := range n.Nodes {
+			if err := checkTemplateNode(tree, child, ranges); err != nil {
+				return err
+			}
+		}
+	case *parse.TemplateNode:
+		return nodeError(tree, n, "template is not allowed")
+	case *parse.RangeNode:
+		if ranges+1 > maxRangeDepth {
+			return nodeError(tree, n, fmt.Sprintf("ranges nested deeper than %d", maxRangeDepth))
+		}
+		if !rangeOverData(n.Pipe) {
+			return nodeError(tree, n, "range is only allowed over a field or variable")
+		}
+		if err := checkBranch(tree, n.List, n.ElseList, ranges+1); err != nil {
+			return err
+		}
+		n.List.Nodes = append([]parse.Node{renderStepNode}, n.List.Nodes...)
+	case *parse.IfNode:
+		return checkBranch(tree, n.List, n.ElseList, ranges)
+	case *parse.WithNode:
+		return checkBranch(tree, n.List, n.ElseList, ranges)
+	}
+	return nil
+}
+
+// rangeOverData reports whether pipe is a lone field, variable or dot.
+func rangeOverData(pipe *parse.PipeNode) bool {
+	if len(pipe.Cmds) != 1 || len(pipe.Cmds[0].Args) != 1 {
+		return false
+	}
+	switch pipe.Cmds[0].Args[0].Type() {
+	case parse.NodeField, parse.NodeVariable, parse.NodeDot:
+		return true
+	}
+	return false
+}
+
+// nodeError reports msg at the position of node, like text/template errors.
+func nodeError(tree *parse.Tree, node parse.Node, msg string) error {
+	location, _ := tree.ErrorContext(node)
+	return fmt.Errorf("template: %s: %s", location, msg)
+}
+
+func checkBranch(tree *parse.Tree, list *parse.ListNode, elseList *parse.ListNode, ranges int) error {
+	if err := checkTemplateNode(tree, list, ranges); err != nil {
+		return err
+	}
+	return checkTemplateNode(tree, elseList, ranges)
+}
+
+// renderWriter fails writes past its limit or deadline, which aborts execution.
+type renderWriter struct {
+	buf      bytes.Buffer
+	limit    int
+	deadline time.Time
+}
+
+func (w *renderWriter) Write(p []byte) (int, error) {
+	if time.Now().After(w.deadline) {
+		return 0, ErrRenderTimeout
+	}
+	if w.buf.Len()+len(p) > w.limit {
+		return 0, ErrRenderOutputLimit
+	}
+	return w.buf.Write(p)
+}
+
+func renderString(svc StringService, text string, data map[string]interface{}) (string, error) {
+	tmpl, err := parseTemplate(svc, text)
+	if err != nil {
+		return "", err
+	}
+
+	timer := time.NewTimer(renderTimeout)
+	defer timer.Stop()
+
+	select {
+	case renderSlots <- struct{}{}:
+	case <-timer.C:
+		return "", ErrRenderTimeout
+	}
+
+	// The slot is only released once execution has returned, which the
+	// step budget bounds even when the caller has stopped waiting.
+	deadline := time.Now().Add(renderTimeout)
+	budget := &renderBudget{steps: maxRenderSteps, deadline: deadline}
+	tmpl.Funcs(template.FuncMap{renderStepFunc: budget.step})
+	w := &renderWriter{limit: maxRenderOutput, deadline: deadline}
+	done := make(chan error, 1)
+	go func() {
+		defer func() { <-renderSlots }()
+		done <- tmpl.Execute(w, data)
+	}()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			return "", err
+		}
+		return w.buf.String(), nil
+	case <-timer.C:
+		return "", ErrRenderTimeout
+	}
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRender(t *testing.T) {
+	svc := stringService{}
+	data := map[string]interface{}{
+		"name":  "crème brûlée",
+		"items": []interface{}{"a", "b"},
+		"n":     3.0,
+	}
+
+	for _, tc := range []struct {
+		tmpl string
+		want string
+		err  string
+	}{
+		{`Hello {{.name | uppercase}}!`, "Hello CRÈME BRÛLÉE!", ""},
+		{`{{.name | slugify}} {{range .items}}[{{.}}]{{end}} {{encode "hex" "hi"}}`, "creme-brulee [a][b] 6869", ""},
+		{`{{range $i, $e := .items}}{{$i}}{{$e}}{{else}}none{{end}}`, "0a1b", ""},
+		{`{{.missing}}`, "", `map has no entry for key "missing"`},
+		{`{{.name | shout}}`, "", `function "shout" not defined`},
+		{`{{call .name}}`, "", "call is not allowed"},
+		{`{{range 1000000000}}{{end}}`, "", "render:1:8: range is only allowed over a field or variable"},
+		{`{{range (1000000000)}}{{end}}`, "", "range is only allowed over a field or variable"},
+		{`{{range .items | len}}{{end}}`, "", "range is only allowed over a field or variable"},
+		{`{{range .items}}{{range .items}}{{range .items}}{{end}}{{end}}{{end}}`, "", "ranges nested deeper than 2"},
+		{`{{define "x"}}{{end}}`, "", "define and block are not allowed"},
+		{`{{template "render"}}`, "", "template is not allowed"},
+		{`{{range .items}}{{printf "%0999999d" 0}}{{end}}`, "", ErrRenderOutputLimit.Error()},
+	} {
+		got, err := renderString(svc, tc.tmpl, data)
+		if tc.err == "" {
+			assert.NoError(t, err, tc.tmpl)
+		} else if assert.Error(t, err, tc.tmpl) {
+			assert.Contains(t, err.Error(), tc.err, tc.tmpl)
+		}
+		assert.Equal(t, tc.want, got, tc.tmpl)
+	}
+}
+
+func TestRenderStepLimit(t *testing.T) {
+	svc := stringService{}
+	items := make([]interface{}, 1000)
+	data := map[string]interface{}{"items": items}
+
+	// Loops writing nothing are stopped by the step budget, not the writer.
+	for _, tmpl := range []string{
+		`{{$n := 1000000000}}{{range $n}}{{end}}`,
+		`{{range .items}}{{range $.items}}{{end}}{{end}}`,
+	} {
+		start := time.Now()
+		_, err := renderString(svc, tmpl, data)
+		if assert.Error(t, err, tmpl) {
+			assert.True(t, strings.Contains(err.Error(), ErrRenderSteps.Error()) || strings.Contains(err.Error(), ErrRenderTimeout.Error()), err.Error())
+		}
+		assert.True(t, time.Since(start) < time.Second, tmpl)
+	}
+
+	// Every slot is released once the runaway executions have stopped.
+	for i := 0; i < cap(renderSlots); i++ {
+		_, _ = renderString(svc, `{{range .items}}{{range $.items}}{{end}}{{end}}`, data)
+	}
+	got, err := renderString(svc, `{{len .items}}`, data)
+	assert.NoError(t, err)
+	assert.Equal(t, "1000", got)
+}
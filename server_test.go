@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
+	"net"
 	"net/http"
 	"testing"
 )
@@ -19,7 +20,8 @@ var (
 )
 
 func TestHTTPServer(t *testing.T) {
-	runHTTPServer(consulClient, makeSvc(), httpAddr)
+	svc := makeSvc()
+	runHTTPServer(consulClient, svc, makeJobs(svc), httpAddr)
 	jwtToken := httpJwtAuth(t)
 	httpUppercase(t, jwtToken)
 }
@@ -72,7 +74,8 @@ func httpUppercase(t *testing.T, jwtToken string) {
 }
 
 func TestGRPCServer(t *testing.T) {
-	runGRPCServer(consulClient, makeSvc(), grpcAddr)
+	svc := makeSvc()
+	runGRPCServer(consulClient, svc, makeJobs(svc), grpcAddr)
 	jwtToken := grpcJwtAuth(t)
 	grpcUppercase(t, jwtToken)
 }
@@ -125,8 +128,32 @@ func grpcUppercase(t *testing.T, jwtToken string) {
 	assert.Equal(t, response.V, "HELLO, THIS RESPONSE FOR GRPC REQUEST!")
 }
 
+// dialTestGRPCServer serves svc on a random local port and dials it.
+func dialTestGRPCServer(t *testing.T, svc StringService) (*grpc.ClientConn, func()) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := makeGRPCServer(svc, makeJobs(svc))
+	go func() { _ = srv.Serve(ln) }()
+
+	conn, err := grpc.Dial(ln.Addr().String(), grpc.WithInsecure())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return conn, func() {
+		_ = conn.Close()
+		srv.Stop()
+	}
+}
+
 func makeSvc() StringService {
-	svc = stringService{authConfig}
+	svc = stringService{auth: authConfig, hmacKeys: hmacKeys, redactKey: redactKey, stopWords: stopWords}
 	svc = loggingMiddleware{authConfig, logger, svc}
 	return svc
+}
+
+func makeJobs(svc StringService) JobService {
+	return newJobManager(svc, nil, jobWorkers, jobQueueSize, jobTTL, jobRetained, jobRetainedBytes)
 }
\ No newline at end of file
@@ -1,33 +1,129 @@
 package main
 
 import (
+	"context"
 	"errors"
-	"strings"
+
+	"golang.org/x/text/cases"
 )
 
 type StringService interface {
-	Uppercase(string) (string, error)
-	Count(string) int64
+	Uppercase(string, string) (string, error)
+	Lowercase(string, string) (string, error)
+	TitleCase(string, string) (string, error)
+	Count(string, string) (int64, error)
+	Normalize(string, NormalizeOptions) (string, error)
+	Analyze(string) TextStats
+	Pipeline(context.Context, string, []PipelineStep, bool) (PipelineResult, error)
+	Replace(string, ReplaceOptions) (string, error)
+	Extract(string, ExtractOptions) ([]RegexMatch, error)
+	Encode(string, string) (string, error)
+	Decode(string, string) (string, error)
+	Hash(string, HashOptions) (string, error)
+	Compare(string, string) (CompareResult, error)
+	Diff(string, string, DiffOptions) (DiffResult, error)
+	Slugify(string, SlugOptions) (string, error)
+	Tokenize(string, TokenizeOptions) ([]Token, error)
+	Render(string, map[string]interface{}) (string, error)
+	Redact(string, RedactOptions) (RedactResult, error)
+	DetectLanguage(string) LanguageResult
+	Truncate(string, TruncateOptions) (string, error)
+	Wrap(string, int) (string, error)
 	HealthCheck() bool
 	Auth(string, string) (string, error)
 }
 
 type stringService struct {
-	auth AuthService
+	auth      AuthService
+	hmacKeys  map[string][]byte
+	redactKey []byte
+	stopWords map[string][]string
 }
 
 var ErrEmpty = errors.New("empty string")
 
-func (ss stringService) Uppercase(s string) (token string, err error) {
-	if s == "" {
-		return "", ErrEmpty
-	}
+func (ss stringService) Uppercase(s string, locale string) (string, error) {
+	return caseString(s, locale, cases.Upper)
+}
+
+func (ss stringService) Lowercase(s string, locale string) (string, error) {
+	return caseString(s, locale, cases.Lower)
+}
+
+func (ss stringService) TitleCase(s string, locale string) (string, error) {
+	return caseString(s, locale, cases.Title)
+}
+
+func (ss stringService) Count(s string, unit string) (int64, error) {
+	return countString(s, unit)
+}
+
+func (ss stringService) Normalize(s string, opts NormalizeOptions) (string, error) {
+	return normalizeString(s, opts)
+}
+
+func (ss stringService) Analyze(s string) TextStats {
+	return analyzeString(s)
+}
+
+func (ss stringService) Pipeline(ctx context.Context, s string, steps []PipelineStep, intermediate bool) (PipelineResult, error) {
+	return runPipeline(ctx, ss, s, steps, intermediate)
+}
+
+func (ss stringService) Replace(s string, opts ReplaceOptions) (string, error) {
+	return replaceString(s, opts)
+}
+
+func (ss stringService) Extract(s string, opts ExtractOptions) ([]RegexMatch, error) {
+	return extractString(s, opts)
+}
+
+func (ss stringService) Encode(s string, encoding string) (string, error) {
+	return encodeString(s, encoding)
+}
+
+func (ss stringService) Decode(s string, encoding string) (string, error) {
+	return decodeString(s, encoding)
+}
+
+func (ss stringService) Hash(s string, opts HashOptions) (string, error) {
+	return hashString(s, opts, ss.hmacKeys)
+}
+
+func (ss stringService) Compare(a string, b string) (CompareResult, error) {
+	return compareStrings(a, b)
+}
+
+func (ss stringService) Diff(a string, b string, opts DiffOptions) (DiffResult, error) {
+	return diffStrings(a, b, opts)
+}
+
+func (ss stringService) Slugify(s string, opts SlugOptions) (string, error) {
+	return slugifyString(s, opts)
+}
+
+func (ss stringService) Tokenize(s string, opts TokenizeOptions) ([]Token, error) {
+	return tokenizeString(s, opts, ss.stopWords)
+}
+
+func (ss stringService) Render(tmpl string, data map[string]interface{}) (string, error) {
+	return renderString(ss, tmpl, data)
+}
+
+func (ss stringService) Redact(s string, opts RedactOptions) (RedactResult, error) {
+	return redactString(s, opts, ss.redactKey)
+}
+
+func (ss stringService) DetectLanguage(s string) LanguageResult {
+	return detectLanguage(s)
+}
 
-	return strings.ToUpper(s), nil
+func (ss stringService) Truncate(s string, opts TruncateOptions) (string, error) {
+	return truncateString(s, opts)
 }
 
-func (ss stringService) Count(s string) int64 {
-	return int64(len(s))
+func (ss stringService) Wrap(s string, width int) (string, error) {
+	return wrapString(s, width)
 }
 
 func (ss stringService) HealthCheck() bool {
@@ -0,0 +1,161 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/rivo/uniseg"
+)
+
+// maxCompareGraphemes caps each input to Compare; the distances are quadratic.
+const maxCompareGraphemes = 4096
+
+var ErrInputTooLarge = errors.New("input too large")
+
+// CompareResult holds distances between two strings, measured in grapheme
+// clusters. Similarity is the Levenshtein distance normalized to [0, 1].
+type CompareResult struct {
+	Levenshtein        int     `json:"levenshtein"`
+	DamerauLevenshtein int     `json:"damerau_levenshtein"`
+	JaroWinkler        float64 `json:"jaro_winkler"`
+	Similarity         float64 `json:"similarity"`
+}
+
+func graphemes(s string) []string {
+	var clusters []string
+	state := -1
+	for s != "" {
+		var cluster string
+		cluster, s, _, state = uniseg.FirstGraphemeClusterInString(s, state)
+		clusters = append(clusters, cluster)
+	}
+	return clusters
+}
+
+func checkCompareSize(s string) error {
+	if n := uniseg.GraphemeClusterCount(s); n > maxCompareGraphemes {
+		return fmt.Errorf("%w: %d graphemes, at most %d allowed", ErrInputTooLarge, n, maxCompareGraphemes)
+	}
+	return nil
+}
+
+func compareStrings(a string, b string) (CompareResult, error) {
+	if err := checkCompareSize(a); err != nil {
+		return CompareResult{}, err
+	}
+	if err := checkCompareSize(b); err != nil {
+		return CompareResult{}, err
+	}
+	x, y := graphemes(a), graphemes(b)
+
+	result := CompareResult{
+		Levenshtein:        levenshtein(x, y),
+		DamerauLevenshtein: damerauLevenshtein(x, y),
+		JaroWinkler:        jaroWinkler(x, y),
+		Similarity:         1,
+	}
+	if longest := max(len(x), len(y)); longest > 0 {
+		result.Similarity = 1 - float64(result.Levenshtein)/float64(longest)
+	}
+	return result, nil
+}
+
+func levenshtein(a []string, b []string) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
+
+// damerauLevenshtein is the optimal string alignment distance: Levenshtein
+// plus transposition of adjacent clusters, each substring edited at most once.
+func damerauLevenshtein(a []string, b []string) int {
+	rows := [3][]int{}
+	for i := range rows {
+		rows[i] = make([]int, len(b)+1)
+	}
+	for j := range rows[0] {
+		rows[0][j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		before, prev, cur := rows[(i+1)%3], rows[(i+2)%3], rows[i%3]
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				cur[j] = min(cur[j], before[j-2]+1)
+			}
+		}
+	}
+	return rows[len(a)%3][len(b)]
+}
+
+// jaroWinkler boosts the Jaro similarity of strings sharing a prefix of up to four clusters.
+func jaroWinkler(a []string, b []string) float64 {
+	if len(a) == 0 && len(b) == 0 {
+		return 1
+	}
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+
+	window := max(len(a), len(b))/2 - 1
+	if window < 0 {
+		window = 0
+	}
+	matchedA := make([]bool, len(a))
+	matchedB := make([]bool, len(b))
+	matches := 0
+	for i := range a {
+		for j := max(0, i-window); j < min(len(b), i+window+1); j++ {
+			if !matchedB[j] && a[i] == b[j] {
+				matchedA[i], matchedB[j] = true, true
+				matches++
+				break
+			}
+		}
+	}
+	if matches == 0 {
+		return 0
+	}
+
+	transpositions, j := 0, 0
+	for i := range a {
+		if !matchedA[i] {
+			continue
+		}
+		for !matchedB[j] {
+			j++
+		}
+		if a[i] != b[j] {
+			transpositions++
+		}
+		j++
+	}
+
+	m := float64(matches)
+	jaro := (m/float64(len(a)) + m/float64(len(b)) + (m-float64(transpositions)/2)/m) / 3
+
+	prefix := 0
+	for prefix < min(4, len(a), len(b)) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	return jaro + float64(prefix)*0.1*(1-jaro)
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompare(t *testing.T) {
+	for _, tc := range []struct {
+		a, b string
+		want CompareResult
+	}{
+		{"", "", CompareResult{0, 0, 1, 1}},
+		{"kitten", "sitting", CompareResult{3, 3, 0.746, 0.571}},
+		{"MARTHA", "MARHTA", CompareResult{2, 1, 0.961, 0.667}},
+		{"abc", "", CompareResult{3, 3, 0, 0}},
+		// e and a combining acute are one cluster
+		{"cafe\u0301", "cafe", CompareResult{1, 1, 0.883, 0.75}},
+	} {
+		got, err := compareStrings(tc.a, tc.b)
+		assert.NoError(t, err, "%q, %q", tc.a, tc.b)
+		assert.Equal(t, tc.want.Levenshtein, got.Levenshtein, "%q, %q", tc.a, tc.b)
+		assert.Equal(t, tc.want.DamerauLevenshtein, got.DamerauLevenshtein, "%q, %q", tc.a, tc.b)
+		assert.InDelta(t, tc.want.JaroWinkler, got.JaroWinkler, 0.001, "%q, %q", tc.a, tc.b)
+		assert.InDelta(t, tc.want.Similarity, got.Similarity, 0.001, "%q, %q", tc.a, tc.b)
+	}
+
+	_, err := compareStrings(strings.Repeat("a", maxCompareGraphemes+1), "")
+	assert.True(t, errors.Is(err, ErrInputTooLarge), "oversized input: %v", err)
+}
+
+func TestDiff(t *testing.T) {
+	a := "a\nb\nc\nd\ne\nf\ng\nh\ni\nj\nk\n"
+	b := "a\nB\nc\nd\ne\nf\ng\nh\ni\nj\nk\nl"
+	got, err := diffStrings(a, b, DiffOptions{})
+	assert.NoError(t, err)
+	// Same output as diff -u
+	assert.Equal(t, "--- a\n+++ b\n"+
+		"@@ -1,5 +1,5 @@\n a\n-b\n+B\n c\n d\n e\n"+
+		"@@ -9,3 +9,4 @@\n i\n j\n k\n+l\n\\ No newline at end of file\n", got.Unified)
+
+	got, err = diffStrings("kitten", "sitting", DiffOptions{Mode: DiffChars, Format: DiffEdits})
+	assert.NoError(t, err)
+	assert.Equal(t, []DiffEdit{
+		{"delete", "k"}, {"insert", "s"}, {"equal", "itt"}, {"delete", "e"}, {"insert", "i"}, {"equal", "n"}, {"insert", "g"},
+	}, got.Edits)
+
+	got, err = diffStrings(a, a, DiffOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "", got.Unified, "identical inputs")
+}
+
+func TestDiffUnifiedByChars(t *testing.T) {
+	got, err := diffStrings("kitten", "sitting", DiffOptions{Mode: DiffChars})
+	assert.NoError(t, err)
+	assert.Equal(t, "--- a\n+++ b\n@@ -1,6 +1,7 @@\n-k\n+s\n itt\n-e\n+i\n n\n+g\n", got.Unified)
+
+	// Context counts clusters, and line breaks stay on the run's line.
+	got, err = diffStrings("one line\nand cafe\\", "one line\nand caf\u00e9s\\", DiffOptions{Mode: DiffChars, Context: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, "--- a\n+++ b\n@@ -15,4 +15,5 @@\n af\n-e\n+\u00e9s\n \\\\\n", got.Unified)
+
+	got, err = diffStrings("a\nb", "a\r\nb", DiffOptions{Mode: DiffChars, Context: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, "--- a\n+++ b\n@@ -1,3 +1,3 @@\n a\n-\\n\n+\\r\\n\n b\n", got.Unified)
+}
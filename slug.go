@@ -0,0 +1,145 @@
+package main
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Slug limits
+const (
+	defaultSlugSeparator = "-"
+	maxSlugLength        = 256
+)
+
+var (
+	ErrUnknownSeparator = errors.New("unknown separator, expected -, _ or .")
+	ErrSlugLength       = errors.New("max length out of range")
+	ErrNoUniqueSlug     = errors.New("no unique slug fits in max length")
+)
+
+// SlugOptions configures Slugify. Separator defaults to "-" and MaxLength,
+// in bytes, to no limit. A slug found in Existing gets a numeric suffix.
+type SlugOptions struct {
+	Separator string
+	MaxLength int
+	Existing  []string
+}
+
+// transliterations map lowercase letters without a decomposition to ASCII to
+// their romanization: Cyrillic, Greek and the Latin letters that are not
+// simply a base letter with diacritics.
+var transliterations = map[rune]string{
+	// Cyrillic
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "yo",
+	'ж': "zh", 'з': "z", 'и': "i", 'й': "y", 'к': "k", 'л': "l", 'м': "m",
+	'н': "n", 'о': "o", 'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u",
+	'ф': "f", 'х': "kh", 'ц': "ts", 'ч': "ch", 'ш': "sh", 'щ': "shch",
+	'ъ': "", 'ы': "y", 'ь': "", 'э': "e", 'ю': "yu", 'я': "ya",
+	'є': "ye", 'і': "i", 'ї': "yi", 'ґ': "g", 'ў': "u",
+	'ђ': "dj", 'ј': "j", 'љ': "lj", 'њ': "nj", 'ћ': "c", 'џ': "dz",
+	'ѓ': "gj", 'ќ': "kj", 'ѕ': "dz",
+	// Greek, accents are stripped before lookup
+	'α': "a", 'β': "v", 'γ': "g", 'δ': "d", 'ε': "e", 'ζ': "z", 'η': "i",
+	'θ': "th", 'ι': "i", 'κ': "k", 'λ': "l", 'μ': "m", 'ν': "n", 'ξ': "x",
+	'ο': "o", 'π': "p", 'ρ': "r", 'σ': "s", 'ς': "s", 'τ': "t", 'υ': "y",
+	'φ': "f", 'χ': "ch", 'ψ': "ps", 'ω': "o",
+	// Latin
+	'ß': "ss", 'æ': "ae", 'œ': "oe", 'ø': "o", 'đ': "d", 'ð': "d",
+	'þ': "th", 'ł': "l", 'ı': "i", 'ħ': "h", 'ŋ': "ng", 'ĸ': "k",
+}
+
+// transliterate lowercases s and rewrites it in ASCII. Letters are looked up
+// in transliterations, then decomposed with their combining marks dropped;
+// anything left outside ASCII is dropped too.
+func transliterate(s string) string {
+	var out strings.Builder
+	for _, r := range strings.ToLower(norm.NFKC.String(s)) {
+		if t, ok := transliterations[r]; ok {
+			out.WriteString(t)
+			continue
+		}
+		for _, d := range norm.NFD.String(string(r)) {
+			switch t, ok := transliterations[d]; {
+			case ok:
+				out.WriteString(t)
+			case d < unicode.MaxASCII:
+				out.WriteRune(d)
+			}
+		}
+	}
+	return out.String()
+}
+
+func isSlugChar(r rune) bool {
+	return 'a' <= r && r <= 'z' || '0' <= r && r <= '9'
+}
+
+// truncateSlug cuts slug to at most limit bytes, at the last separator that fits
+// when there is one.
+func truncateSlug(slug string, sep string, limit int) string {
+	if limit <= 0 || len(slug) <= limit {
+		return slug
+	}
+	if i := strings.LastIndex(slug[:limit+len(sep)], sep); i > 0 {
+		return slug[:i]
+	}
+	return slug[:limit]
+}
+
+func checkSlugOptions(opts SlugOptions) error {
+	switch opts.Separator {
+	case "", "-", "_", ".":
+	default:
+		return ErrUnknownSeparator
+	}
+	if opts.MaxLength < 0 || opts.MaxLength > maxSlugLength {
+		return ErrSlugLength
+	}
+	return nil
+}
+
+func slugifyString(s string, opts SlugOptions) (string, error) {
+	if err := checkSlugOptions(opts); err != nil {
+		return "", err
+	}
+	sep := opts.Separator
+	if sep == "" {
+		sep = defaultSlugSeparator
+	}
+
+	words := strings.FieldsFunc(transliterate(s), func(r rune) bool { return !isSlugChar(r) })
+	slug := truncateSlug(strings.Join(words, sep), sep, opts.MaxLength)
+	if slug == "" {
+		return "", ErrEmpty
+	}
+
+	taken := make(map[string]bool, len(opts.Existing))
+	for _, e := range opts.Existing {
+		taken[e] = true
+	}
+	candidate := slug
+	for n := 2; taken[candidate]; n++ {
+		suffix := sep + strconv.Itoa(n)
+		if opts.MaxLength > 0 && opts.MaxLength <= len(suffix) {
+			return "", ErrNoUniqueSlug
+		}
+		candidate = truncateSlug(slug, sep, opts.MaxLength-len(suffix)) + suffix
+	}
+	return candidate, nil
+}
+
+func init() {
+	registerPipelineOp("slugify", pipelineOp{
+		params: []string{"separator"},
+		check: func(params map[string]string) error {
+			return checkSlugOptions(SlugOptions{Separator: params["separator"]})
+		},
+		apply: func(svc StringService, s string, params map[string]string) (string, error) {
+			return svc.Slugify(s, SlugOptions{Separator: params["separator"]})
+		},
+	})
+}
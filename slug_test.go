@@ -0,0 +1,33 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSlugify(t *testing.T) {
+	for _, tc := range []struct {
+		s    string
+		opts SlugOptions
+		want string
+		err  error
+	}{
+		{"Hello, World!", SlugOptions{}, "hello-world", nil},
+		{"Crème Brûlée  -- à la carte", SlugOptions{}, "creme-brulee-a-la-carte", nil},
+		{"Привет, мир", SlugOptions{}, "privet-mir", nil},
+		{"Щёлково Їжак", SlugOptions{}, "shchyolkovo-yizhak", nil},
+		{"Καλημέρα κόσμε", SlugOptions{}, "kalimera-kosme", nil},
+		{"Straße Łódź Ærø", SlugOptions{Separator: "_"}, "strasse_lodz_aero", nil},
+		{"the quick brown fox", SlugOptions{MaxLength: 12}, "the-quick", nil},
+		{"supercalifragilistic", SlugOptions{MaxLength: 5}, "super", nil},
+		{"Hello World", SlugOptions{Existing: []string{"hello-world", "hello-world-2"}}, "hello-world-3", nil},
+		{"the quick brown fox", SlugOptions{MaxLength: 9, Existing: []string{"the-quick"}}, "the-2", nil},
+		{"日本語", SlugOptions{}, "", ErrEmpty},
+		{"x", SlugOptions{Separator: "+"}, "", ErrUnknownSeparator},
+	} {
+		got, err := slugifyString(tc.s, tc.opts)
+		assert.Equal(t, tc.err, err, tc.s)
+		assert.Equal(t, tc.want, got, tc.s)
+	}
+}
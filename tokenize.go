@@ -0,0 +1,138 @@
+package main
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/rivo/uniseg"
+)
+
+// Token units and limits
+const (
+	TokenWords     = "words"
+	TokenSentences = "sentences"
+	TokenNGrams    = "ngrams"
+	defaultNGram   = 2
+	maxNGram       = 8
+)
+
+var (
+	ErrUnknownTokenUnit = errors.New("unknown unit, expected words, sentences or ngrams")
+	ErrNGramSize        = errors.New("n-gram size out of range")
+	ErrUnknownStopWords = errors.New("unknown stop word list")
+)
+
+// TokenizeOptions configures Tokenize. Unit defaults to words and N, the
+// number of words per n-gram, to 2. StopWords names a configured list of
+// words dropped before n-grams are built; it does not apply to sentences.
+type TokenizeOptions struct {
+	Unit      string
+	N         int
+	Lowercase bool
+	StopWords string
+}
+
+// Token is a segment of the input with its byte and rune offsets. Text is
+// lowercased when requested, the offsets always refer to the input.
+type Token struct {
+	Text      string `json:"text"`
+	Start     int    `json:"start"`
+	End       int    `json:"end"`
+	RuneStart int    `json:"rune_start"`
+	RuneEnd   int    `json:"rune_end"`
+}
+
+func checkTokenizeOptions(opts TokenizeOptions, stopWords map[string][]string) error {
+	switch opts.Unit {
+	case "", TokenWords, TokenSentences, TokenNGrams:
+	default:
+		return ErrUnknownTokenUnit
+	}
+	if opts.N < 0 || opts.N > maxNGram {
+		return ErrNGramSize
+	}
+	if _, ok := stopWords[opts.StopWords]; opts.StopWords != "" && !ok {
+		return ErrUnknownStopWords
+	}
+	return nil
+}
+
+// segmentString splits s with next, one of the uniseg First*InString
+// functions, and keeps the segments accepted by keep, trimmed of trailing space.
+func segmentString(s string, next func(string, int) (string, string, int), keep func(string) bool) []Token {
+	var tokens []Token
+	offset, runeOffset, state := 0, 0, -1
+	for rest := s; rest != ""; {
+		var seg string
+		seg, rest, state = next(rest, state)
+		text := strings.TrimRightFunc(seg, unicode.IsSpace)
+		if keep(text) {
+			n := utf8.RuneCountInString(text)
+			tokens = append(tokens, Token{text, offset, offset + len(text), runeOffset, runeOffset + n})
+		}
+		offset += len(seg)
+		runeOffset += utf8.RuneCountInString(seg)
+	}
+	return tokens
+}
+
+func firstWord(s string, state int) (string, string, int) {
+	return uniseg.FirstWordInString(s, state)
+}
+
+func firstSentence(s string, state int) (string, string, int) {
+	return uniseg.FirstSentenceInString(s, state)
+}
+
+func tokenizeString(s string, opts TokenizeOptions, stopWords map[string][]string) ([]Token, error) {
+	if err := checkTokenizeOptions(opts, stopWords); err != nil {
+		return nil, err
+	}
+
+	var tokens []Token
+	if opts.Unit == TokenSentences {
+		tokens = segmentString(s, firstSentence, func(text string) bool { return text != "" })
+	} else {
+		stop := map[string]bool{}
+		for _, w := range stopWords[opts.StopWords] {
+			stop[strings.ToLower(w)] = true
+		}
+		tokens = segmentString(s, firstWord, func(text string) bool {
+			return isWordSegment(text) && !stop[strings.ToLower(text)]
+		})
+	}
+
+	if opts.Unit == TokenNGrams {
+		n := opts.N
+		if n == 0 {
+			n = defaultNGram
+		}
+		tokens = nGrams(tokens, n)
+	}
+
+	if opts.Lowercase {
+		for i := range tokens {
+			tokens[i].Text = strings.ToLower(tokens[i].Text)
+		}
+	}
+	if tokens == nil {
+		tokens = []Token{}
+	}
+	return tokens, nil
+}
+
+// nGrams joins every run of n consecutive words with a space.
+func nGrams(words []Token, n int) []Token {
+	var grams []Token
+	for i := 0; i+n <= len(words); i++ {
+		texts := make([]string, n)
+		for j := range texts {
+			texts[j] = words[i+j].Text
+		}
+		first, last := words[i], words[i+n-1]
+		grams = append(grams, Token{strings.Join(texts, " "), first.Start, last.End, first.RuneStart, last.RuneEnd})
+	}
+	return grams
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenize(t *testing.T) {
+	lists := map[string][]string{"en": englishStopWords}
+	s := "Héllo, the World! Don't stop. "
+
+	for _, tc := range []struct {
+		opts TokenizeOptions
+		want []Token
+	}{
+		{TokenizeOptions{}, []Token{
+			{"Héllo", 0, 6, 0, 5}, {"the", 8, 11, 7, 10}, {"World", 12, 17, 11, 16}, {"Don't", 19, 24, 18, 23}, {"stop", 25, 29, 24, 28},
+		}},
+		{TokenizeOptions{Lowercase: true, StopWords: "en"}, []Token{
+			{"héllo", 0, 6, 0, 5}, {"world", 12, 17, 11, 16}, {"don't", 19, 24, 18, 23}, {"stop", 25, 29, 24, 28},
+		}},
+		{TokenizeOptions{Unit: TokenSentences}, []Token{
+			{"Héllo, the World!", 0, 18, 0, 17}, {"Don't stop.", 19, 30, 18, 29},
+		}},
+		{TokenizeOptions{Unit: TokenNGrams, N: 3, StopWords: "en"}, []Token{
+			{"Héllo World Don't", 0, 24, 0, 23}, {"World Don't stop", 12, 29, 11, 28},
+		}},
+	} {
+		got, err := tokenizeString(s, tc.opts, lists)
+		assert.NoError(t, err, "%+v", tc.opts)
+		assert.Equal(t, tc.want, got, "%+v", tc.opts)
+	}
+
+	_, err := tokenizeString(s, TokenizeOptions{StopWords: "xx"}, lists)
+	assert.Equal(t, ErrUnknownStopWords, err)
+}
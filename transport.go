@@ -3,7 +3,8 @@ package main
 // Requests and Responses
 
 type uppercaseRequest struct {
-	S string `json:"s"`
+	S      string `json:"s"`
+	Locale string `json:"locale,omitempty"`
 }
 
 type uppercaseResponse struct {
@@ -11,12 +12,278 @@ type uppercaseResponse struct {
 	Err string `json:"err,omitempty"`
 }
 
+type lowercaseRequest struct {
+	S      string `json:"s"`
+	Locale string `json:"locale,omitempty"`
+}
+
+type lowercaseResponse struct {
+	V   string `json:"v"`
+	Err string `json:"err,omitempty"`
+}
+
+type titleCaseRequest struct {
+	S      string `json:"s"`
+	Locale string `json:"locale,omitempty"`
+}
+
+type titleCaseResponse struct {
+	V   string `json:"v"`
+	Err string `json:"err,omitempty"`
+}
+
 type countRequest struct {
-	S string `json:"s"`
+	S    string `json:"s"`
+	Unit string `json:"unit,omitempty"`
 }
 
 type countResponse struct {
-	V int64 `json:"v"`
+	V   int64  `json:"v"`
+	Err string `json:"err,omitempty"`
+}
+
+type normalizeRequest struct {
+	S               string `json:"s"`
+	Form            string `json:"form,omitempty"`
+	CaseFold        bool   `json:"case_fold,omitempty"`
+	StripDiacritics bool   `json:"strip_diacritics,omitempty"`
+}
+
+type normalizeResponse struct {
+	V   string `json:"v"`
+	Err string `json:"err,omitempty"`
+}
+
+type analyzeRequest struct {
+	S string `json:"s"`
+}
+
+type analyzeResponse struct {
+	TextStats
+}
+
+type batchRequest struct {
+	Items []batchItem `json:"items"`
+}
+
+type batchResponse struct {
+	Results []batchResult `json:"results"`
+}
+
+type transformRequest struct {
+	Op string `json:"op"`
+	S  string `json:"s"`
+}
+
+type transformResponse struct {
+	V   string `json:"v,omitempty"`
+	N   int64  `json:"n,omitempty"`
+	Err string `json:"err,omitempty"`
+}
+
+type pipelineRequest struct {
+	S            string         `json:"s"`
+	Steps        []PipelineStep `json:"steps"`
+	Intermediate bool           `json:"intermediate,omitempty"`
+}
+
+type pipelineResponse struct {
+	V            string   `json:"v"`
+	Intermediate []string `json:"intermediate,omitempty"`
+	Err          string   `json:"err,omitempty"`
+}
+
+type replaceRequest struct {
+	S           string `json:"s"`
+	Pattern     string `json:"pattern"`
+	Replacement string `json:"replacement"`
+	Flags       string `json:"flags,omitempty"`
+}
+
+type replaceResponse struct {
+	V   string `json:"v"`
+	Err string `json:"err,omitempty"`
+}
+
+type extractRequest struct {
+	S       string `json:"s"`
+	Pattern string `json:"pattern"`
+	Flags   string `json:"flags,omitempty"`
+	Limit   int    `json:"limit,omitempty"`
+}
+
+type extractResponse struct {
+	Matches []RegexMatch `json:"matches"`
+	Err     string       `json:"err,omitempty"`
+}
+
+type encodeStringRequest struct {
+	S        string `json:"s"`
+	Encoding string `json:"encoding"`
+}
+
+type encodeStringResponse struct {
+	V   string `json:"v"`
+	Err string `json:"err,omitempty"`
+}
+
+type decodeStringRequest struct {
+	S        string `json:"s"`
+	Encoding string `json:"encoding"`
+}
+
+type decodeStringResponse struct {
+	V   string `json:"v"`
+	Err string `json:"err,omitempty"`
+}
+
+type hashRequest struct {
+	S         string `json:"s"`
+	Algorithm string `json:"algorithm"`
+	Output    string `json:"output,omitempty"`
+	Key       string `json:"key,omitempty"`
+}
+
+type hashResponse struct {
+	V   string `json:"v"`
+	Err string `json:"err,omitempty"`
+}
+
+type compareRequest struct {
+	A string `json:"a"`
+	B string `json:"b"`
+}
+
+type compareResponse struct {
+	CompareResult
+	Err string `json:"err,omitempty"`
+}
+
+type diffRequest struct {
+	A       string `json:"a"`
+	B       string `json:"b"`
+	Mode    string `json:"mode,omitempty"`
+	Format  string `json:"format,omitempty"`
+	Context int    `json:"context,omitempty"`
+}
+
+type diffResponse struct {
+	Unified string     `json:"unified,omitempty"`
+	Edits   []DiffEdit `json:"edits,omitempty"`
+	Err     string     `json:"err,omitempty"`
+}
+
+type slugifyRequest struct {
+	S         string   `json:"s"`
+	Separator string   `json:"separator,omitempty"`
+	MaxLength int      `json:"max_length,omitempty"`
+	Existing  []string `json:"existing,omitempty"`
+}
+
+type slugifyResponse struct {
+	V   string `json:"v"`
+	Err string `json:"err,omitempty"`
+}
+
+type tokenizeRequest struct {
+	S         string `json:"s"`
+	Unit      string `json:"unit,omitempty"`
+	N         int    `json:"n,omitempty"`
+	Lowercase bool   `json:"lowercase,omitempty"`
+	StopWords string `json:"stop_words,omitempty"`
+}
+
+type tokenizeResponse struct {
+	Tokens []Token `json:"tokens"`
+	Err    string  `json:"err,omitempty"`
+}
+
+type renderRequest struct {
+	Template string                 `json:"template"`
+	Data     map[string]interface{} `json:"data,omitempty"`
+}
+
+type renderResponse struct {
+	V   string `json:"v"`
+	Err string `json:"err,omitempty"`
+}
+
+type redactRequest struct {
+	S         string   `json:"s"`
+	Detectors []string `json:"detectors,omitempty"`
+	Style     string   `json:"style,omitempty"`
+}
+
+type redactResponse struct {
+	V        string    `json:"v"`
+	Findings []Finding `json:"findings"`
+	Err      string    `json:"err,omitempty"`
+}
+
+type detectLanguageRequest struct {
+	S string `json:"s"`
+}
+
+type detectLanguageResponse struct {
+	LanguageResult
+}
+
+type truncateRequest struct {
+	S        string `json:"s"`
+	Unit     string `json:"unit,omitempty"`
+	Max      int    `json:"max"`
+	Ellipsis string `json:"ellipsis,omitempty"`
+}
+
+type truncateResponse struct {
+	V   string `json:"v"`
+	Err string `json:"err,omitempty"`
+}
+
+type wrapRequest struct {
+	S     string `json:"s"`
+	Width int    `json:"width"`
+}
+
+type wrapResponse struct {
+	V   string `json:"v"`
+	Err string `json:"err,omitempty"`
+}
+
+type submitJobRequest struct {
+	Batch       *batchRequest    `json:"batch,omitempty"`
+	Pipeline    *pipelineRequest `json:"pipeline,omitempty"`
+	CallbackURL string           `json:"callback_url,omitempty"`
+}
+
+type jobRequest struct {
+	ID string `json:"id"`
+}
+
+type jobResponse struct {
+	ID        string            `json:"id,omitempty"`
+	Status    string            `json:"status,omitempty"`
+	Done      int               `json:"done"`
+	Total     int               `json:"total"`
+	Results   []batchResult     `json:"results,omitempty"`
+	Pipeline  *pipelineResponse `json:"pipeline,omitempty"`
+	Reason    string            `json:"reason,omitempty"`
+	CreatedAt string            `json:"created_at,omitempty"`
+	UpdatedAt string            `json:"updated_at,omitempty"`
+	Err       string            `json:"err,omitempty"`
+}
+
+type deliveryAttempt struct {
+	Attempt    int    `json:"attempt"`
+	Time       string `json:"time"`
+	StatusCode int    `json:"status_code,omitempty"`
+	Error      string `json:"error,omitempty"`
+	DurationMs int64  `json:"duration_ms"`
+}
+
+type jobDeliveriesResponse struct {
+	Deliveries []deliveryAttempt `json:"deliveries"`
+	Err        string            `json:"err,omitempty"`
 }
 
 type healthRequest struct {}
@@ -0,0 +1,219 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/dgrijalva/jwt-go"
+	gokitjwt "github.com/go-kit/kit/auth/jwt"
+	amqptransport "github.com/go-kit/kit/transport/amqp"
+	"github.com/streadway/amqp"
+)
+
+// AMQP messages name their operation in the type property. Requests with a
+// reply_to get a reply carrying their correlation ID, others are processed
+// fire-and-forget.
+const (
+	amqpTypeUppercase = "uppercase"
+	amqpTypeCount     = "count"
+	amqpStatusHeader  = "Status-Code"
+	amqpPrefetch      = 16
+)
+
+func decodeUppercaseAMQPRequest(_ context.Context, deliv *amqp.Delivery) (interface{}, error) {
+	var request uppercaseRequest
+	if err := decodeAMQPDelivery(deliv, &request); err != nil {
+		return nil, err
+	}
+
+	return request, nil
+}
+
+func decodeCountAMQPRequest(_ context.Context, deliv *amqp.Delivery) (interface{}, error) {
+	var request countRequest
+	if err := decodeAMQPDelivery(deliv, &request); err != nil {
+		return nil, err
+	}
+
+	return request, nil
+}
+
+func decodeAMQPDelivery(deliv *amqp.Delivery, v validator) error {
+	if int64(len(deliv.Body)) > limits.maxBodyBytes {
+		return validationError{{"body", fmt.Sprintf("exceeds %d bytes", limits.maxBodyBytes)}}
+	}
+	return decodeJSON(deliv.Body, v)
+}
+
+func amqpHeader(deliv *amqp.Delivery, name string) string {
+	v, _ := deliv.Headers[name].(string)
+	return v
+}
+
+// amqpToContext moves the bearer token of the Authorization header and the
+// request ID into the context.
+func amqpToContext(ctx context.Context, pub *amqp.Publishing, deliv *amqp.Delivery) context.Context {
+	pub.ContentType = "application/json"
+	ctx = contextWithRequestID(ctx, amqpHeader(deliv, requestIDHeader))
+	parts := strings.Fields(amqpHeader(deliv, "Authorization"))
+	if len(parts) != 2 || !strings.EqualFold(parts[0], "Bearer") {
+		return ctx
+	}
+	return context.WithValue(ctx, gokitjwt.JWTTokenContextKey, parts[1])
+}
+
+// publishAMQPReply replies when the request asked for it and acknowledges it.
+func publishAMQPReply(_ context.Context, deliv *amqp.Delivery, ch amqptransport.Channel, pub *amqp.Publishing) error {
+	if deliv.ReplyTo != "" {
+		pub.CorrelationId = deliv.CorrelationId
+		if err := ch.Publish("", deliv.ReplyTo, false, false, *pub); err != nil {
+			return err
+		}
+	}
+	return deliv.Ack(false)
+}
+
+// encodeAMQPError replies like encodeNATSError. Malformed requests are
+// rejected without requeueing, so that the broker dead-letters them; other
+// failures are acknowledged since retrying them would fail the same way.
+func encodeAMQPError(_ context.Context, err error, deliv *amqp.Delivery, ch amqptransport.Channel, pub *amqp.Publishing) {
+	if deliv.ReplyTo != "" {
+		body, code := errorMessage(err)
+		pub.Body, pub.CorrelationId = body, deliv.CorrelationId
+		pub.Headers = amqp.Table{amqpStatusHeader: int32(code)}
+		if err := ch.Publish("", deliv.ReplyTo, false, false, *pub); err != nil {
+			_ = logger.Log("transport", "amqp", "err", err)
+		}
+	}
+
+	if _, malformed := err.(validationError); malformed {
+		_ = deliv.Nack(false, false)
+		return
+	}
+	_ = deliv.Ack(false)
+}
+
+// AMQP Handler
+
+func makeAMQPSubscribers(svc StringService) map[string]*amqptransport.Subscriber {
+	kf := func(token *jwt.Token) (interface{}, error) {
+		return authConfig.key, nil
+	}
+	clf := func() jwt.Claims {
+		return &customClaims{}
+	}
+	options := []amqptransport.SubscriberOption{
+		amqptransport.SubscriberBefore(amqpToContext),
+		amqptransport.SubscriberResponsePublisher(publishAMQPReply),
+		amqptransport.SubscriberErrorEncoder(encodeAMQPError),
+	}
+
+	recovered := recoveryMiddleware(logger, panicsTotal)
+	authenticated := authenticateMiddleware(gokitjwt.NewParser(kf, jwt.SigningMethodHS256, clf))
+
+	return map[string]*amqptransport.Subscriber{
+		amqpTypeUppercase: amqptransport.NewSubscriber(
+			recovered(authenticated(makeUppercaseEndpoint(svc))),
+			decodeUppercaseAMQPRequest,
+			amqptransport.EncodeJSONResponse,
+			options...,
+		),
+		amqpTypeCount: amqptransport.NewSubscriber(
+			recovered(authenticated(makeCountEndpoint(svc))),
+			decodeCountAMQPRequest,
+			amqptransport.EncodeJSONResponse,
+			options...,
+		),
+	}
+}
+
+// makeAMQPHandler dispatches deliveries to the subscriber of their type.
+// Panics outside the endpoint fail the delivery instead of the process.
+func makeAMQPHandler(ch amqptransport.Channel, svc StringService) func(*amqp.Delivery) {
+	subscribers := map[string]func(*amqp.Delivery){}
+	for typ, subscriber := range makeAMQPSubscribers(svc) {
+		subscribers[typ] = subscriber.ServeDelivery(ch)
+	}
+
+	return func(deliv *amqp.Delivery) {
+		ctx := contextWithRequestID(context.Background(), amqpHeader(deliv, requestIDHeader))
+		defer func() {
+			if r := recover(); r != nil {
+				encodeAMQPError(ctx, logPanic(ctx, logger, panicsTotal, "amqp", r), deliv, ch, &amqp.Publishing{})
+			}
+		}()
+
+		serve, ok := subscribers[deliv.Type]
+		if !ok {
+			err := validationError{{"type", "must be one of " + amqpTypeUppercase + ", " + amqpTypeCount}}
+			encodeAMQPError(ctx, err, deliv, ch, &amqp.Publishing{ContentType: "application/json"})
+			return
+		}
+		serve(deliv)
+	}
+}
+
+// serveAMQP consumes queue on ch until the channel is closed.
+func serveAMQP(ch amqptransport.Channel, queue string, svc StringService) error {
+	deliveries, err := ch.Consume(queue, "", false, false, false, false, nil)
+	if err != nil {
+		return err
+	}
+
+	handle := makeAMQPHandler(ch, svc)
+	go func() {
+		for deliv := range deliveries {
+			deliv := deliv
+			go handle(&deliv)
+		}
+	}()
+	return nil
+}
+
+// consumeAMQP serves queue on a new connection to url until it is closed.
+func consumeAMQP(svc StringService, url string, queue string) error {
+	conn, err := amqp.Dial(url)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	ch, err := conn.Channel()
+	if err != nil {
+		return err
+	}
+	if err := declareAMQPQueue(ch, queue); err != nil {
+		return err
+	}
+	closed := conn.NotifyClose(make(chan *amqp.Error, 1))
+	if err := serveAMQP(ch, queue, svc); err != nil {
+		return err
+	}
+	_ = logger.Log("output", fmt.Sprintf("Consuming AMQP queue %s", queue))
+
+	if err := <-closed; err != nil {
+		return err
+	}
+	return nil
+}
+
+// declareAMQPQueue declares queue with a dead-letter exchange of the same
+// name suffixed by ".dead-letter", bound to a queue of that name.
+func declareAMQPQueue(ch *amqp.Channel, queue string) error {
+	deadLetter := queue + ".dead-letter"
+	if err := ch.ExchangeDeclare(deadLetter, amqp.ExchangeFanout, true, false, false, false, nil); err != nil {
+		return err
+	}
+	if _, err := ch.QueueDeclare(deadLetter, true, false, false, false, nil); err != nil {
+		return err
+	}
+	if err := ch.QueueBind(deadLetter, "", deadLetter, false, nil); err != nil {
+		return err
+	}
+	_, err := ch.QueueDeclare(queue, true, false, false, false, amqp.Table{"x-dead-letter-exchange": deadLetter})
+	if err != nil {
+		return err
+	}
+	return ch.Qos(amqpPrefetch, 0, false)
+}
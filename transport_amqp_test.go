@@ -0,0 +1,126 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/streadway/amqp"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeChannel stands in for a broker: deliveries sent to it are consumed
+// and publishings are collected.
+type fakeChannel struct {
+	deliveries chan amqp.Delivery
+	published  chan amqp.Publishing
+}
+
+func newFakeChannel() *fakeChannel {
+	return &fakeChannel{make(chan amqp.Delivery), make(chan amqp.Publishing, 10)}
+}
+
+func (c *fakeChannel) Publish(exchange, key string, mandatory, immediate bool, msg amqp.Publishing) error {
+	c.published <- msg
+	return nil
+}
+
+func (c *fakeChannel) Consume(queue, consumer string, autoAck, exclusive, noLocal, noWait bool, args amqp.Table) (<-chan amqp.Delivery, error) {
+	return c.deliveries, nil
+}
+
+// fakeAcknowledger reports how a delivery was settled.
+type fakeAcknowledger chan string
+
+func (a fakeAcknowledger) Ack(tag uint64, multiple bool) error {
+	a <- "ack"
+	return nil
+}
+
+func (a fakeAcknowledger) Nack(tag uint64, multiple bool, requeue bool) error {
+	if requeue {
+		a <- "requeue"
+	} else {
+		a <- "dead-letter"
+	}
+	return nil
+}
+
+func (a fakeAcknowledger) Reject(tag uint64, requeue bool) error {
+	return a.Nack(tag, false, requeue)
+}
+
+func TestAMQPTransport(t *testing.T) {
+	ch := newFakeChannel()
+	if err := serveAMQP(ch, amqpQueue, makeSvc()); err != nil {
+		t.Fatal(err)
+	}
+	token := testToken(t)
+
+	deliver := func(typ string, body string, replyTo string) (string, *amqp.Publishing) {
+		settled := make(fakeAcknowledger, 1)
+		ch.deliveries <- amqp.Delivery{
+			Acknowledger:  settled,
+			Type:          typ,
+			Body:          []byte(body),
+			ReplyTo:       replyTo,
+			CorrelationId: "correlation-1",
+			Headers:       amqp.Table{"Authorization": "Bearer " + token},
+		}
+
+		var outcome string
+		select {
+		case outcome = <-settled:
+		case <-time.After(5 * time.Second):
+			t.Fatal("delivery not settled")
+		}
+		if replyTo == "" {
+			return outcome, nil
+		}
+		select {
+		case reply := <-ch.published:
+			return outcome, &reply
+		case <-time.After(5 * time.Second):
+			t.Fatal("no reply")
+		}
+		return outcome, nil
+	}
+
+	outcome, reply := deliver(amqpTypeUppercase, `{"s": "hello, amqp"}`, "replies")
+	assert.Equal(t, "ack", outcome)
+	assert.Equal(t, "correlation-1", reply.CorrelationId)
+	var upper uppercaseResponse
+	_ = json.Unmarshal(reply.Body, &upper)
+	assert.Equal(t, "HELLO, AMQP", upper.V)
+
+	outcome, reply = deliver(amqpTypeCount, `{"s": "héllo", "unit": "runes"}`, "replies")
+	assert.Equal(t, "ack", outcome)
+	var count countResponse
+	_ = json.Unmarshal(reply.Body, &count)
+	assert.Equal(t, int64(5), count.V)
+
+	// Fire-and-forget requests are processed without a reply.
+	outcome, _ = deliver(amqpTypeUppercase, `{"s": "quiet"}`, "")
+	assert.Equal(t, "ack", outcome)
+	assert.Empty(t, ch.published)
+
+	// Malformed messages are dead-lettered, with an error reply when asked for.
+	outcome, reply = deliver(amqpTypeCount, `{"s": `, "replies")
+	assert.Equal(t, "dead-letter", outcome)
+	assert.Equal(t, int32(http.StatusBadRequest), reply.Headers[amqpStatusHeader])
+	outcome, _ = deliver("reverse", `{"s": "abc"}`, "")
+	assert.Equal(t, "dead-letter", outcome)
+}
+
+func TestAMQPUnauthenticated(t *testing.T) {
+	ch := newFakeChannel()
+	handle := makeAMQPHandler(ch, makeSvc())
+
+	settled := make(fakeAcknowledger, 1)
+	handle(&amqp.Delivery{Acknowledger: settled, Type: amqpTypeUppercase, Body: []byte(`{"s": "hello"}`), ReplyTo: "replies"})
+
+	assert.Equal(t, "ack", <-settled)
+	reply := <-ch.published
+	assert.Equal(t, int32(http.StatusUnauthorized), reply.Headers[amqpStatusHeader])
+}
@@ -2,10 +2,12 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"github.com/dgrijalva/jwt-go"
 	"github.com/fnaumov/gokit-stringsvc/pb"
 	gokitjwt "github.com/go-kit/kit/auth/jwt"
 	grpctransport "github.com/go-kit/kit/transport/grpc"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/health"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 )
@@ -14,7 +16,11 @@ import (
 
 func decodeUppercaseGRPCRequest(ctx context.Context, req interface{}) (interface{}, error) {
 	r := req.(*pb.UppercaseRequest)
-	return uppercaseRequest{S: r.S}, nil
+	request := uppercaseRequest{S: r.S, Locale: r.Locale}
+	if err := request.validate(); err != nil {
+		return nil, err
+	}
+	return request, nil
 }
 
 func encodeUppercaseGRPCResponse(ctx context.Context, resp interface{}) (interface{}, error) {
@@ -22,19 +28,461 @@ func encodeUppercaseGRPCResponse(ctx context.Context, resp interface{}) (interfa
 	return &pb.UppercaseResponse{V: r.V, Err: r.Err}, nil
 }
 
+func decodeLowercaseGRPCRequest(ctx context.Context, req interface{}) (interface{}, error) {
+	r := req.(*pb.LowercaseRequest)
+	request := lowercaseRequest{S: r.S, Locale: r.Locale}
+	if err := request.validate(); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+func encodeLowercaseGRPCResponse(ctx context.Context, resp interface{}) (interface{}, error) {
+	r := resp.(lowercaseResponse)
+	return &pb.LowercaseResponse{V: r.V, Err: r.Err}, nil
+}
+
+func decodeTitleCaseGRPCRequest(ctx context.Context, req interface{}) (interface{}, error) {
+	r := req.(*pb.TitleCaseRequest)
+	request := titleCaseRequest{S: r.S, Locale: r.Locale}
+	if err := request.validate(); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+func encodeTitleCaseGRPCResponse(ctx context.Context, resp interface{}) (interface{}, error) {
+	r := resp.(titleCaseResponse)
+	return &pb.TitleCaseResponse{V: r.V, Err: r.Err}, nil
+}
+
 func decodeCountGRPCRequest(ctx context.Context, req interface{}) (interface{}, error) {
 	r := req.(*pb.CountRequest)
-	return countRequest{S: r.S}, nil
+	request := countRequest{S: r.S, Unit: r.Unit}
+	if err := request.validate(); err != nil {
+		return nil, err
+	}
+	return request, nil
 }
 
 func encodeCountGRPCResponse(ctx context.Context, resp interface{}) (interface{}, error) {
 	r := resp.(countResponse)
-	return &pb.CountResponse{V: r.V}, nil
+	return &pb.CountResponse{V: r.V, Err: r.Err}, nil
+}
+
+func decodeNormalizeGRPCRequest(ctx context.Context, req interface{}) (interface{}, error) {
+	r := req.(*pb.NormalizeRequest)
+	request := normalizeRequest{S: r.S, Form: r.Form, CaseFold: r.CaseFold, StripDiacritics: r.StripDiacritics}
+	if err := request.validate(); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+func encodeNormalizeGRPCResponse(ctx context.Context, resp interface{}) (interface{}, error) {
+	r := resp.(normalizeResponse)
+	return &pb.NormalizeResponse{V: r.V, Err: r.Err}, nil
+}
+
+func decodeAnalyzeGRPCRequest(ctx context.Context, req interface{}) (interface{}, error) {
+	r := req.(*pb.AnalyzeRequest)
+	request := analyzeRequest{S: r.S}
+	if err := request.validate(); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+func encodeAnalyzeGRPCResponse(ctx context.Context, resp interface{}) (interface{}, error) {
+	r := resp.(analyzeResponse)
+	return &pb.AnalyzeResponse{
+		Bytes:      r.Bytes,
+		Runes:      r.Runes,
+		Graphemes:  r.Graphemes,
+		Words:      r.Words,
+		Sentences:  r.Sentences,
+		Lines:      r.Lines,
+		Whitespace: r.Whitespace,
+		Distinct:   r.Distinct,
+		Scripts:    r.Scripts,
+		Frequency:  r.Frequency,
+	}, nil
+}
+
+func decodeBatchGRPCRequest(ctx context.Context, req interface{}) (interface{}, error) {
+	r := req.(*pb.BatchRequest)
+	request := batchRequest{Items: make([]batchItem, len(r.Items))}
+	for i, item := range r.Items {
+		request.Items[i] = batchItem{Op: item.Op, S: item.S}
+	}
+	if err := request.validate(); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+func encodeBatchGRPCResponse(ctx context.Context, resp interface{}) (interface{}, error) {
+	r := resp.(batchResponse)
+	results := make([]*pb.BatchResult, len(r.Results))
+	for i, result := range r.Results {
+		results[i] = &pb.BatchResult{V: result.V, N: result.N, Err: result.Err}
+	}
+	return &pb.BatchResponse{Results: results}, nil
+}
+
+func decodeTransformGRPCRequest(ctx context.Context, req interface{}) (interface{}, error) {
+	r := req.(*pb.TransformRequest)
+	request := transformRequest{Op: r.Op, S: r.S}
+	if err := request.validate(); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+func encodeTransformGRPCResponse(ctx context.Context, resp interface{}) (interface{}, error) {
+	r := resp.(transformResponse)
+	return &pb.TransformResponse{V: r.V, N: r.N, Err: r.Err}, nil
+}
+
+func encodeTransformGRPCError(err error) interface{} {
+	return &pb.TransformResponse{Err: err.Error()}
+}
+
+func decodePipelineGRPCRequest(ctx context.Context, req interface{}) (interface{}, error) {
+	r := req.(*pb.PipelineRequest)
+	request := pipelineRequest{S: r.S, Steps: make([]PipelineStep, len(r.Steps)), Intermediate: r.Intermediate}
+	for i, step := range r.Steps {
+		request.Steps[i] = PipelineStep{Op: step.Op, Params: step.Params}
+	}
+	if err := request.validate(); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+func encodePipelineGRPCResponse(ctx context.Context, resp interface{}) (interface{}, error) {
+	r := resp.(pipelineResponse)
+	return &pb.PipelineResponse{V: r.V, Intermediate: r.Intermediate, Err: r.Err}, nil
+}
+
+func decodeReplaceGRPCRequest(ctx context.Context, req interface{}) (interface{}, error) {
+	r := req.(*pb.ReplaceRequest)
+	request := replaceRequest{S: r.S, Pattern: r.Pattern, Replacement: r.Replacement, Flags: r.Flags}
+	if err := request.validate(); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+func encodeReplaceGRPCResponse(ctx context.Context, resp interface{}) (interface{}, error) {
+	r := resp.(replaceResponse)
+	return &pb.ReplaceResponse{V: r.V, Err: r.Err}, nil
+}
+
+func decodeExtractGRPCRequest(ctx context.Context, req interface{}) (interface{}, error) {
+	r := req.(*pb.ExtractRequest)
+	request := extractRequest{S: r.S, Pattern: r.Pattern, Flags: r.Flags, Limit: int(r.Limit)}
+	if err := request.validate(); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+func encodeExtractGRPCResponse(ctx context.Context, resp interface{}) (interface{}, error) {
+	r := resp.(extractResponse)
+	matches := make([]*pb.RegexMatch, len(r.Matches))
+	for i, m := range r.Matches {
+		matches[i] = &pb.RegexMatch{
+			Text:   m.Text,
+			Start:  int64(m.Start),
+			End:    int64(m.End),
+			Groups: m.Groups,
+			Named:  m.Named,
+		}
+	}
+	return &pb.ExtractResponse{Matches: matches, Err: r.Err}, nil
+}
+
+func decodeEncodeGRPCRequest(ctx context.Context, req interface{}) (interface{}, error) {
+	r := req.(*pb.EncodeRequest)
+	request := encodeStringRequest{S: r.S, Encoding: r.Encoding}
+	if err := request.validate(); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+func encodeEncodeGRPCResponse(ctx context.Context, resp interface{}) (interface{}, error) {
+	r := resp.(encodeStringResponse)
+	return &pb.EncodeResponse{V: r.V, Err: r.Err}, nil
+}
+
+func decodeDecodeGRPCRequest(ctx context.Context, req interface{}) (interface{}, error) {
+	r := req.(*pb.DecodeRequest)
+	request := decodeStringRequest{S: r.S, Encoding: r.Encoding}
+	if err := request.validate(); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+func encodeDecodeGRPCResponse(ctx context.Context, resp interface{}) (interface{}, error) {
+	r := resp.(decodeStringResponse)
+	return &pb.DecodeResponse{V: r.V, Err: r.Err}, nil
+}
+
+func decodeHashGRPCRequest(ctx context.Context, req interface{}) (interface{}, error) {
+	r := req.(*pb.HashRequest)
+	request := hashRequest{S: r.S, Algorithm: r.Algorithm, Output: r.Output, Key: r.Key}
+	if err := request.validate(); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+func encodeHashGRPCResponse(ctx context.Context, resp interface{}) (interface{}, error) {
+	r := resp.(hashResponse)
+	return &pb.HashResponse{V: r.V, Err: r.Err}, nil
+}
+
+func decodeCompareGRPCRequest(ctx context.Context, req interface{}) (interface{}, error) {
+	r := req.(*pb.CompareRequest)
+	request := compareRequest{A: r.A, B: r.B}
+	if err := request.validate(); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+func encodeCompareGRPCResponse(ctx context.Context, resp interface{}) (interface{}, error) {
+	r := resp.(compareResponse)
+	return &pb.CompareResponse{
+		Levenshtein:        int64(r.Levenshtein),
+		DamerauLevenshtein: int64(r.DamerauLevenshtein),
+		JaroWinkler:        r.JaroWinkler,
+		Similarity:         r.Similarity,
+		Err:                r.Err,
+	}, nil
+}
+
+func decodeDiffGRPCRequest(ctx context.Context, req interface{}) (interface{}, error) {
+	r := req.(*pb.DiffRequest)
+	request := diffRequest{A: r.A, B: r.B, Mode: r.Mode, Format: r.Format, Context: int(r.Context)}
+	if err := request.validate(); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+func encodeDiffGRPCResponse(ctx context.Context, resp interface{}) (interface{}, error) {
+	r := resp.(diffResponse)
+	edits := make([]*pb.DiffEdit, len(r.Edits))
+	for i, e := range r.Edits {
+		edits[i] = &pb.DiffEdit{Op: e.Op, Text: e.Text}
+	}
+	return &pb.DiffResponse{Unified: r.Unified, Edits: edits, Err: r.Err}, nil
+}
+
+func decodeSlugifyGRPCRequest(ctx context.Context, req interface{}) (interface{}, error) {
+	r := req.(*pb.SlugifyRequest)
+	request := slugifyRequest{S: r.S, Separator: r.Separator, MaxLength: int(r.MaxLength), Existing: r.Existing}
+	if err := request.validate(); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+func encodeSlugifyGRPCResponse(ctx context.Context, resp interface{}) (interface{}, error) {
+	r := resp.(slugifyResponse)
+	return &pb.SlugifyResponse{V: r.V, Err: r.Err}, nil
+}
+
+func decodeTokenizeGRPCRequest(ctx context.Context, req interface{}) (interface{}, error) {
+	r := req.(*pb.TokenizeRequest)
+	request := tokenizeRequest{S: r.S, Unit: r.Unit, N: int(r.N), Lowercase: r.Lowercase, StopWords: r.StopWords}
+	if err := request.validate(); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+func encodeTokenizeGRPCResponse(ctx context.Context, resp interface{}) (interface{}, error) {
+	r := resp.(tokenizeResponse)
+	tokens := make([]*pb.Token, len(r.Tokens))
+	for i, t := range r.Tokens {
+		tokens[i] = &pb.Token{
+			Text:      t.Text,
+			Start:     int64(t.Start),
+			End:       int64(t.End),
+			RuneStart: int64(t.RuneStart),
+			RuneEnd:   int64(t.RuneEnd),
+		}
+	}
+	return &pb.TokenizeResponse{Tokens: tokens, Err: r.Err}, nil
+}
+
+func decodeRenderGRPCRequest(ctx context.Context, req interface{}) (interface{}, error) {
+	r := req.(*pb.RenderRequest)
+	request := renderRequest{Template: r.Template}
+	if r.Data != "" {
+		if err := json.Unmarshal([]byte(r.Data), &request.Data); err != nil {
+			return nil, validationError{{"data", "must be a JSON object"}}
+		}
+	}
+	if err := request.validate(); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+func encodeRenderGRPCResponse(ctx context.Context, resp interface{}) (interface{}, error) {
+	r := resp.(renderResponse)
+	return &pb.RenderResponse{V: r.V, Err: r.Err}, nil
+}
+
+func decodeRedactGRPCRequest(ctx context.Context, req interface{}) (interface{}, error) {
+	r := req.(*pb.RedactRequest)
+	request := redactRequest{S: r.S, Detectors: r.Detectors, Style: r.Style}
+	if err := request.validate(); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+func encodeRedactGRPCResponse(ctx context.Context, resp interface{}) (interface{}, error) {
+	r := resp.(redactResponse)
+	findings := make([]*pb.Finding, len(r.Findings))
+	for i, f := range r.Findings {
+		findings[i] = &pb.Finding{Type: f.Type, Start: int64(f.Start), End: int64(f.End)}
+	}
+	return &pb.RedactResponse{V: r.V, Findings: findings, Err: r.Err}, nil
+}
+
+func decodeDetectLanguageGRPCRequest(ctx context.Context, req interface{}) (interface{}, error) {
+	r := req.(*pb.DetectLanguageRequest)
+	request := detectLanguageRequest{S: r.S}
+	if err := request.validate(); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+func encodeDetectLanguageGRPCResponse(ctx context.Context, resp interface{}) (interface{}, error) {
+	r := resp.(detectLanguageResponse)
+	languages := make([]*pb.LanguageScore, len(r.Languages))
+	for i, l := range r.Languages {
+		languages[i] = &pb.LanguageScore{Language: l.Language, Confidence: l.Confidence}
+	}
+	return &pb.DetectLanguageResponse{Script: r.Script, Languages: languages}, nil
+}
+
+func decodeTruncateGRPCRequest(ctx context.Context, req interface{}) (interface{}, error) {
+	r := req.(*pb.TruncateRequest)
+	request := truncateRequest{S: r.S, Unit: r.Unit, Max: int(r.Max), Ellipsis: r.Ellipsis}
+	if err := request.validate(); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+func encodeTruncateGRPCResponse(ctx context.Context, resp interface{}) (interface{}, error) {
+	r := resp.(truncateResponse)
+	return &pb.TruncateResponse{V: r.V, Err: r.Err}, nil
+}
+
+func decodeWrapGRPCRequest(ctx context.Context, req interface{}) (interface{}, error) {
+	r := req.(*pb.WrapRequest)
+	request := wrapRequest{S: r.S, Width: int(r.Width)}
+	if err := request.validate(); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+func encodeWrapGRPCResponse(ctx context.Context, resp interface{}) (interface{}, error) {
+	r := resp.(wrapResponse)
+	return &pb.WrapResponse{V: r.V, Err: r.Err}, nil
+}
+
+func decodeSubmitJobGRPCRequest(ctx context.Context, req interface{}) (interface{}, error) {
+	r := req.(*pb.SubmitJobRequest)
+	request := submitJobRequest{CallbackURL: r.CallbackUrl}
+	if r.Batch != nil {
+		batch, err := decodeBatchGRPCRequest(ctx, r.Batch)
+		if err != nil {
+			return nil, nestedFields("batch", err)
+		}
+		b := batch.(batchRequest)
+		request.Batch = &b
+	}
+	if r.Pipeline != nil {
+		pipeline, err := decodePipelineGRPCRequest(ctx, r.Pipeline)
+		if err != nil {
+			return nil, nestedFields("pipeline", err)
+		}
+		p := pipeline.(pipelineRequest)
+		request.Pipeline = &p
+	}
+	if err := request.validate(); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+func decodeJobGRPCRequest(ctx context.Context, req interface{}) (interface{}, error) {
+	r := req.(*pb.JobRequest)
+	request := jobRequest{ID: r.Id}
+	if err := request.validate(); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+func encodeJobGRPCResponse(ctx context.Context, resp interface{}) (interface{}, error) {
+	r := resp.(jobResponse)
+	results := make([]*pb.BatchResult, len(r.Results))
+	for i, result := range r.Results {
+		results[i] = &pb.BatchResult{V: result.V, N: result.N, Err: result.Err}
+	}
+	response := &pb.JobResponse{
+		Id:        r.ID,
+		Status:    r.Status,
+		Done:      int64(r.Done),
+		Total:     int64(r.Total),
+		Results:   results,
+		Reason:    r.Reason,
+		CreatedAt: r.CreatedAt,
+		UpdatedAt: r.UpdatedAt,
+		Err:       r.Err,
+	}
+	if r.Pipeline != nil {
+		response.Pipeline = &pb.PipelineResponse{V: r.Pipeline.V, Intermediate: r.Pipeline.Intermediate}
+	}
+	return response, nil
+}
+
+func encodeJobDeliveriesGRPCResponse(ctx context.Context, resp interface{}) (interface{}, error) {
+	r := resp.(jobDeliveriesResponse)
+	deliveries := make([]*pb.DeliveryAttempt, len(r.Deliveries))
+	for i, a := range r.Deliveries {
+		deliveries[i] = &pb.DeliveryAttempt{
+			Attempt:    int64(a.Attempt),
+			Time:       a.Time,
+			StatusCode: int64(a.StatusCode),
+			Error:      a.Error,
+			DurationMs: a.DurationMs,
+		}
+	}
+	return &pb.JobDeliveriesResponse{Deliveries: deliveries, Err: r.Err}, nil
 }
 
 func decodeAuthGRPCRequest(ctx context.Context, req interface{}) (interface{}, error) {
 	r := req.(*pb.AuthRequest)
-	return authRequest{Username: r.Username, Password: r.Password}, nil
+	request := authRequest{Username: r.Username, Password: r.Password}
+	if err := request.validate(); err != nil {
+		return nil, err
+	}
+	return request, nil
 }
 
 func encodeAuthGRPCResponse(ctx context.Context, resp interface{}) (interface{}, error) {
@@ -48,7 +496,32 @@ type grpcBinding struct {
 	svc StringService
 	healthServer *health.Server
 	uppercase grpctransport.Handler
+	lowercase grpctransport.Handler
+	titleCase grpctransport.Handler
 	count grpctransport.Handler
+	normalize grpctransport.Handler
+	analyze grpctransport.Handler
+	batch grpctransport.Handler
+	transform grpcStreamServer
+	pipeline grpctransport.Handler
+	replace grpctransport.Handler
+	extract grpctransport.Handler
+	encode grpctransport.Handler
+	decode grpctransport.Handler
+	hash grpctransport.Handler
+	compare grpctransport.Handler
+	diff grpctransport.Handler
+	slugify grpctransport.Handler
+	tokenize grpctransport.Handler
+	render grpctransport.Handler
+	redact grpctransport.Handler
+	detectLanguage grpctransport.Handler
+	truncate grpctransport.Handler
+	wrap grpctransport.Handler
+	submitJob grpctransport.Handler
+	getJob grpctransport.Handler
+	cancelJob grpctransport.Handler
+	jobDeliveries grpctransport.Handler
 	auth grpctransport.Handler
 }
 
@@ -60,6 +533,22 @@ func (g grpcBinding) Uppercase(ctx context.Context, req *pb.UppercaseRequest) (*
 	return response.(*pb.UppercaseResponse), nil
 }
 
+func (g grpcBinding) Lowercase(ctx context.Context, req *pb.LowercaseRequest) (*pb.LowercaseResponse, error) {
+	_, response, err := g.lowercase.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return response.(*pb.LowercaseResponse), nil
+}
+
+func (g grpcBinding) TitleCase(ctx context.Context, req *pb.TitleCaseRequest) (*pb.TitleCaseResponse, error) {
+	_, response, err := g.titleCase.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return response.(*pb.TitleCaseResponse), nil
+}
+
 func (g grpcBinding) Count(ctx context.Context, req *pb.CountRequest) (*pb.CountResponse, error) {
 	_, response, err := g.count.ServeGRPC(ctx, req)
 	if err != nil {
@@ -68,6 +557,158 @@ func (g grpcBinding) Count(ctx context.Context, req *pb.CountRequest) (*pb.Count
 	return response.(*pb.CountResponse), nil
 }
 
+func (g grpcBinding) Normalize(ctx context.Context, req *pb.NormalizeRequest) (*pb.NormalizeResponse, error) {
+	_, response, err := g.normalize.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return response.(*pb.NormalizeResponse), nil
+}
+
+func (g grpcBinding) Analyze(ctx context.Context, req *pb.AnalyzeRequest) (*pb.AnalyzeResponse, error) {
+	_, response, err := g.analyze.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return response.(*pb.AnalyzeResponse), nil
+}
+
+func (g grpcBinding) Batch(ctx context.Context, req *pb.BatchRequest) (*pb.BatchResponse, error) {
+	_, response, err := g.batch.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return response.(*pb.BatchResponse), nil
+}
+
+func (g grpcBinding) Transform(stream pb.StringService_TransformServer) error {
+	return g.transform.ServeStream(
+		stream.Context(),
+		func() (interface{}, error) { return stream.Recv() },
+		func(msg interface{}) error { return stream.Send(msg.(*pb.TransformResponse)) },
+	)
+}
+
+func (g grpcBinding) Pipeline(ctx context.Context, req *pb.PipelineRequest) (*pb.PipelineResponse, error) {
+	_, response, err := g.pipeline.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return response.(*pb.PipelineResponse), nil
+}
+
+func (g grpcBinding) Replace(ctx context.Context, req *pb.ReplaceRequest) (*pb.ReplaceResponse, error) {
+	_, response, err := g.replace.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return response.(*pb.ReplaceResponse), nil
+}
+
+func (g grpcBinding) Extract(ctx context.Context, req *pb.ExtractRequest) (*pb.ExtractResponse, error) {
+	_, response, err := g.extract.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return response.(*pb.ExtractResponse), nil
+}
+
+func (g grpcBinding) Encode(ctx context.Context, req *pb.EncodeRequest) (*pb.EncodeResponse, error) {
+	_, response, err := g.encode.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return response.(*pb.EncodeResponse), nil
+}
+
+func (g grpcBinding) Decode(ctx context.Context, req *pb.DecodeRequest) (*pb.DecodeResponse, error) {
+	_, response, err := g.decode.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return response.(*pb.DecodeResponse), nil
+}
+
+func (g grpcBinding) Hash(ctx context.Context, req *pb.HashRequest) (*pb.HashResponse, error) {
+	_, response, err := g.hash.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return response.(*pb.HashResponse), nil
+}
+
+func (g grpcBinding) Compare(ctx context.Context, req *pb.CompareRequest) (*pb.CompareResponse, error) {
+	_, response, err := g.compare.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return response.(*pb.CompareResponse), nil
+}
+
+func (g grpcBinding) Diff(ctx context.Context, req *pb.DiffRequest) (*pb.DiffResponse, error) {
+	_, response, err := g.diff.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return response.(*pb.DiffResponse), nil
+}
+
+func (g grpcBinding) Slugify(ctx context.Context, req *pb.SlugifyRequest) (*pb.SlugifyResponse, error) {
+	_, response, err := g.slugify.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return response.(*pb.SlugifyResponse), nil
+}
+
+func (g grpcBinding) Tokenize(ctx context.Context, req *pb.TokenizeRequest) (*pb.TokenizeResponse, error) {
+	_, response, err := g.tokenize.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return response.(*pb.TokenizeResponse), nil
+}
+
+func (g grpcBinding) Render(ctx context.Context, req *pb.RenderRequest) (*pb.RenderResponse, error) {
+	_, response, err := g.render.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return response.(*pb.RenderResponse), nil
+}
+
+func (g grpcBinding) Redact(ctx context.Context, req *pb.RedactRequest) (*pb.RedactResponse, error) {
+	_, response, err := g.redact.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return response.(*pb.RedactResponse), nil
+}
+
+func (g grpcBinding) DetectLanguage(ctx context.Context, req *pb.DetectLanguageRequest) (*pb.DetectLanguageResponse, error) {
+	_, response, err := g.detectLanguage.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return response.(*pb.DetectLanguageResponse), nil
+}
+
+func (g grpcBinding) Truncate(ctx context.Context, req *pb.TruncateRequest) (*pb.TruncateResponse, error) {
+	_, response, err := g.truncate.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return response.(*pb.TruncateResponse), nil
+}
+
+func (g grpcBinding) Wrap(ctx context.Context, req *pb.WrapRequest) (*pb.WrapResponse, error) {
+	_, response, err := g.wrap.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return response.(*pb.WrapResponse), nil
+}
+
 func (g grpcBinding) Check(ctx context.Context, req *healthpb.HealthCheckRequest) (*healthpb.HealthCheckResponse, error) {
 	res, err :=  g.healthServer.Check(ctx, req)
 	return res, err
@@ -78,6 +719,38 @@ func (g grpcBinding) Watch(req *healthpb.HealthCheckRequest, hws healthpb.Health
 	return err
 }
 
+func (g grpcBinding) SubmitJob(ctx context.Context, req *pb.SubmitJobRequest) (*pb.JobResponse, error) {
+	_, response, err := g.submitJob.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return response.(*pb.JobResponse), nil
+}
+
+func (g grpcBinding) GetJob(ctx context.Context, req *pb.JobRequest) (*pb.JobResponse, error) {
+	_, response, err := g.getJob.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return response.(*pb.JobResponse), nil
+}
+
+func (g grpcBinding) CancelJob(ctx context.Context, req *pb.JobRequest) (*pb.JobResponse, error) {
+	_, response, err := g.cancelJob.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return response.(*pb.JobResponse), nil
+}
+
+func (g grpcBinding) GetJobDeliveries(ctx context.Context, req *pb.JobRequest) (*pb.JobDeliveriesResponse, error) {
+	_, response, err := g.jobDeliveries.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return response.(*pb.JobDeliveriesResponse), nil
+}
+
 func (g grpcBinding) Auth(ctx context.Context, req *pb.AuthRequest) (*pb.AuthResponse, error) {
 	_, response, err := g.auth.ServeGRPC(ctx, req)
 	if err != nil {
@@ -88,7 +761,7 @@ func (g grpcBinding) Auth(ctx context.Context, req *pb.AuthRequest) (*pb.AuthRes
 
 // GRPC Handler
 
-func makeGRPCBinding(svc StringService, grpcBind grpcBinding) *grpcBinding {
+func makeGRPCBinding(svc StringService, jobs JobService, grpcBind grpcBinding) *grpcBinding {
 	kf := func(token *jwt.Token) (interface{}, error) {
 		return authConfig.key, nil
 	}
@@ -99,23 +772,201 @@ func makeGRPCBinding(svc StringService, grpcBind grpcBinding) *grpcBinding {
 	options := []grpctransport.ServerOption{
 		grpctransport.ServerBefore(gokitjwt.GRPCToContext()),
 	}
+	recovered := recoveryMiddleware(logger, panicsTotal)
 
 	grpcBind.uppercase = grpctransport.NewServer(
-		gokitjwt.NewParser(kf, jwt.SigningMethodHS256, clf)(makeUppercaseEndpoint(svc)),
+		recovered(gokitjwt.NewParser(kf, jwt.SigningMethodHS256, clf)(makeUppercaseEndpoint(svc))),
 		decodeUppercaseGRPCRequest,
 		encodeUppercaseGRPCResponse,
 		options...,
 	)
 
+	grpcBind.lowercase = grpctransport.NewServer(
+		recovered(gokitjwt.NewParser(kf, jwt.SigningMethodHS256, clf)(makeLowercaseEndpoint(svc))),
+		decodeLowercaseGRPCRequest,
+		encodeLowercaseGRPCResponse,
+		options...,
+	)
+
+	grpcBind.titleCase = grpctransport.NewServer(
+		recovered(gokitjwt.NewParser(kf, jwt.SigningMethodHS256, clf)(makeTitleCaseEndpoint(svc))),
+		decodeTitleCaseGRPCRequest,
+		encodeTitleCaseGRPCResponse,
+		options...,
+	)
+
 	grpcBind.count = grpctransport.NewServer(
-		gokitjwt.NewParser(kf, jwt.SigningMethodHS256, clf)(makeCountEndpoint(svc)),
+		recovered(gokitjwt.NewParser(kf, jwt.SigningMethodHS256, clf)(makeCountEndpoint(svc))),
 		decodeCountGRPCRequest,
 		encodeCountGRPCResponse,
 		options...,
 	)
 
+	grpcBind.normalize = grpctransport.NewServer(
+		recovered(gokitjwt.NewParser(kf, jwt.SigningMethodHS256, clf)(makeNormalizeEndpoint(svc))),
+		decodeNormalizeGRPCRequest,
+		encodeNormalizeGRPCResponse,
+		options...,
+	)
+
+	grpcBind.analyze = grpctransport.NewServer(
+		recovered(gokitjwt.NewParser(kf, jwt.SigningMethodHS256, clf)(makeAnalyzeEndpoint(svc))),
+		decodeAnalyzeGRPCRequest,
+		encodeAnalyzeGRPCResponse,
+		options...,
+	)
+
+	grpcBind.batch = grpctransport.NewServer(
+		recovered(gokitjwt.NewParser(kf, jwt.SigningMethodHS256, clf)(makeBatchEndpoint(svc))),
+		decodeBatchGRPCRequest,
+		encodeBatchGRPCResponse,
+		options...,
+	)
+
+	grpcBind.transform = grpcStreamServer{
+		authenticate: gokitjwt.NewParser(kf, jwt.SigningMethodHS256, clf),
+		e:            recovered(makeTransformEndpoint(svc)),
+		dec:          decodeTransformGRPCRequest,
+		enc:          encodeTransformGRPCResponse,
+		before:       []grpctransport.ServerRequestFunc{gokitjwt.GRPCToContext()},
+		errorEncoder: encodeTransformGRPCError,
+	}
+
+	grpcBind.pipeline = grpctransport.NewServer(
+		recovered(gokitjwt.NewParser(kf, jwt.SigningMethodHS256, clf)(makePipelineEndpoint(svc))),
+		decodePipelineGRPCRequest,
+		encodePipelineGRPCResponse,
+		options...,
+	)
+
+	grpcBind.replace = grpctransport.NewServer(
+		recovered(gokitjwt.NewParser(kf, jwt.SigningMethodHS256, clf)(makeReplaceEndpoint(svc))),
+		decodeReplaceGRPCRequest,
+		encodeReplaceGRPCResponse,
+		options...,
+	)
+
+	grpcBind.extract = grpctransport.NewServer(
+		recovered(gokitjwt.NewParser(kf, jwt.SigningMethodHS256, clf)(makeExtractEndpoint(svc))),
+		decodeExtractGRPCRequest,
+		encodeExtractGRPCResponse,
+		options...,
+	)
+
+	grpcBind.encode = grpctransport.NewServer(
+		recovered(gokitjwt.NewParser(kf, jwt.SigningMethodHS256, clf)(makeEncodeEndpoint(svc))),
+		decodeEncodeGRPCRequest,
+		encodeEncodeGRPCResponse,
+		options...,
+	)
+
+	grpcBind.decode = grpctransport.NewServer(
+		recovered(gokitjwt.NewParser(kf, jwt.SigningMethodHS256, clf)(makeDecodeEndpoint(svc))),
+		decodeDecodeGRPCRequest,
+		encodeDecodeGRPCResponse,
+		options...,
+	)
+
+	grpcBind.hash = grpctransport.NewServer(
+		recovered(gokitjwt.NewParser(kf, jwt.SigningMethodHS256, clf)(makeHashEndpoint(svc))),
+		decodeHashGRPCRequest,
+		encodeHashGRPCResponse,
+		options...,
+	)
+
+	grpcBind.compare = grpctransport.NewServer(
+		recovered(gokitjwt.NewParser(kf, jwt.SigningMethodHS256, clf)(makeCompareEndpoint(svc))),
+		decodeCompareGRPCRequest,
+		encodeCompareGRPCResponse,
+		options...,
+	)
+
+	grpcBind.diff = grpctransport.NewServer(
+		recovered(gokitjwt.NewParser(kf, jwt.SigningMethodHS256, clf)(makeDiffEndpoint(svc))),
+		decodeDiffGRPCRequest,
+		encodeDiffGRPCResponse,
+		options...,
+	)
+
+	grpcBind.slugify = grpctransport.NewServer(
+		recovered(gokitjwt.NewParser(kf, jwt.SigningMethodHS256, clf)(makeSlugifyEndpoint(svc))),
+		decodeSlugifyGRPCRequest,
+		encodeSlugifyGRPCResponse,
+		options...,
+	)
+
+	grpcBind.tokenize = grpctransport.NewServer(
+		recovered(gokitjwt.NewParser(kf, jwt.SigningMethodHS256, clf)(makeTokenizeEndpoint(svc))),
+		decodeTokenizeGRPCRequest,
+		encodeTokenizeGRPCResponse,
+		options...,
+	)
+
+	grpcBind.render = grpctransport.NewServer(
+		recovered(gokitjwt.NewParser(kf, jwt.SigningMethodHS256, clf)(makeRenderEndpoint(svc))),
+		decodeRenderGRPCRequest,
+		encodeRenderGRPCResponse,
+		options...,
+	)
+
+	grpcBind.redact = grpctransport.NewServer(
+		recovered(gokitjwt.NewParser(kf, jwt.SigningMethodHS256, clf)(makeRedactEndpoint(svc))),
+		decodeRedactGRPCRequest,
+		encodeRedactGRPCResponse,
+		options...,
+	)
+
+	grpcBind.detectLanguage = grpctransport.NewServer(
+		recovered(gokitjwt.NewParser(kf, jwt.SigningMethodHS256, clf)(makeDetectLanguageEndpoint(svc))),
+		decodeDetectLanguageGRPCRequest,
+		encodeDetectLanguageGRPCResponse,
+		options...,
+	)
+
+	grpcBind.truncate = grpctransport.NewServer(
+		recovered(gokitjwt.NewParser(kf, jwt.SigningMethodHS256, clf)(makeTruncateEndpoint(svc))),
+		decodeTruncateGRPCRequest,
+		encodeTruncateGRPCResponse,
+		options...,
+	)
+
+	grpcBind.wrap = grpctransport.NewServer(
+		recovered(gokitjwt.NewParser(kf, jwt.SigningMethodHS256, clf)(makeWrapEndpoint(svc))),
+		decodeWrapGRPCRequest,
+		encodeWrapGRPCResponse,
+		options...,
+	)
+
+	grpcBind.submitJob = grpctransport.NewServer(
+		recovered(gokitjwt.NewParser(kf, jwt.SigningMethodHS256, clf)(makeSubmitJobEndpoint(jobs))),
+		decodeSubmitJobGRPCRequest,
+		encodeJobGRPCResponse,
+		options...,
+	)
+
+	grpcBind.getJob = grpctransport.NewServer(
+		recovered(gokitjwt.NewParser(kf, jwt.SigningMethodHS256, clf)(makeGetJobEndpoint(jobs))),
+		decodeJobGRPCRequest,
+		encodeJobGRPCResponse,
+		options...,
+	)
+
+	grpcBind.cancelJob = grpctransport.NewServer(
+		recovered(gokitjwt.NewParser(kf, jwt.SigningMethodHS256, clf)(makeCancelJobEndpoint(jobs))),
+		decodeJobGRPCRequest,
+		encodeJobGRPCResponse,
+		options...,
+	)
+
+	grpcBind.jobDeliveries = grpctransport.NewServer(
+		recovered(gokitjwt.NewParser(kf, jwt.SigningMethodHS256, clf)(makeJobDeliveriesEndpoint(jobs))),
+		decodeJobGRPCRequest,
+		encodeJobDeliveriesGRPCResponse,
+		options...,
+	)
+
 	grpcBind.auth = grpctransport.NewServer(
-		makeAuthEndpoint(svc),
+		recovered(makeAuthEndpoint(svc)),
 		decodeAuthGRPCRequest,
 		encodeAuthGRPCResponse,
 		options...,
@@ -123,3 +974,41 @@ func makeGRPCBinding(svc StringService, grpcBind grpcBinding) *grpcBinding {
 
 	return &grpcBind
 }
+
+// GRPC Server
+
+func makeGRPCServer(svc StringService, jobs JobService) *grpc.Server {
+	srv := grpc.NewServer(
+		grpc.MaxRecvMsgSize(int(limits.maxBodyBytes)),
+		grpc.UnaryInterceptor(chainUnaryInterceptors(
+			requestIDUnaryInterceptor,
+			recoveryUnaryInterceptor(logger, panicsTotal),
+			idempotencyUnaryInterceptor(
+				newIdempotencyStore(idempotencyWindow, idempotencyEntries, idempotencyBytes),
+				newIdempotencyStore(authIdempotencyWindow, idempotencyEntries, idempotencyBytes),
+			),
+		)),
+		grpc.StreamInterceptor(recoveryStreamInterceptor(logger, panicsTotal)),
+	)
+	healthServer := health.NewServer()
+	grpcBind := grpcBinding{svc: svc, healthServer: healthServer}
+	grpcBinding := makeGRPCBinding(svc, jobs, grpcBind)
+	pb.RegisterStringServiceServer(srv, grpcBinding)
+	healthpb.RegisterHealthServer(srv, grpcBinding)
+
+	return srv
+}
+
+// chainUnaryInterceptors runs interceptors in order, the first one being the outermost.
+func chainUnaryInterceptors(interceptors ...grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		chained := handler
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			next, interceptor := chained, interceptors[i]
+			chained = func(ctx context.Context, req interface{}) (interface{}, error) {
+				return interceptor(ctx, req, info, next)
+			}
+		}
+		return chained(ctx, req)
+	}
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"context"
+	"io"
+
+	"github.com/go-kit/kit/endpoint"
+	grpctransport "github.com/go-kit/kit/transport/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// transformWindow is how many received messages may wait to be processed.
+// Once it is full the stream stops reading and gRPC flow control holds the client back.
+const transformWindow = 16
+
+// grpcStreamServer serves a bidirectional stream through a go-kit endpoint,
+// decoding, handling and encoding every message like grpctransport.Server
+// does for unary calls. The stream is authenticated once, before the first message.
+type grpcStreamServer struct {
+	authenticate endpoint.Middleware
+	e            endpoint.Endpoint
+	dec          grpctransport.DecodeRequestFunc
+	enc          grpctransport.EncodeResponseFunc
+	before       []grpctransport.ServerRequestFunc
+	errorEncoder func(error) interface{}
+}
+
+func (s grpcStreamServer) ServeStream(ctx context.Context, recv func() (interface{}, error), send func(interface{}) error) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	ctx = contextWithRequestID(ctx, requestIDFromMetadata(ctx))
+	for _, f := range s.before {
+		ctx = f(ctx, md)
+	}
+
+	ctx, err := authenticateContext(ctx, s.authenticate)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	received := make(chan interface{}, transformWindow)
+	recvErr := make(chan error, 1)
+	go func() {
+		defer close(received)
+		for {
+			msg, err := recv()
+			if err != nil {
+				if err != io.EOF {
+					recvErr <- err
+				}
+				return
+			}
+			select {
+			case received <- msg:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	for msg := range received {
+		response, err := s.serveMessage(ctx, msg)
+		if err != nil {
+			response = s.errorEncoder(err)
+		}
+		if err := send(response); err != nil {
+			return err
+		}
+	}
+
+	select {
+	case err := <-recvErr:
+		return err
+	default:
+		return nil
+	}
+}
+
+func (s grpcStreamServer) serveMessage(ctx context.Context, msg interface{}) (interface{}, error) {
+	request, err := s.dec(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.e(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.enc(ctx, response)
+}
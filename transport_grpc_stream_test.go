@@ -0,0 +1,75 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/fnaumov/gokit-stringsvc/pb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestGRPCTransformStream(t *testing.T) {
+	conn, stop := dialTestGRPCServer(t, makeSvc())
+	defer stop()
+
+	md := metadata.Pairs("authorization", fmt.Sprintf("Bearer %s", testToken(t)))
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	stream, err := pb.NewStringServiceClient(conn).Transform(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	requests := []*pb.TransformRequest{
+		{Op: OpUppercase, S: "hello"},
+		{Op: "reverse", S: "hello"},
+		{Op: OpCount, S: "hello"},
+		{Op: OpLowercase, S: strings.Repeat("a", limits.maxStringBytes+1)},
+		{Op: OpTitleCase, S: "hello world"},
+	}
+	for _, req := range requests {
+		if err := stream.Send(req); err != nil {
+			t.Fatal(err)
+		}
+	}
+	_ = stream.CloseSend()
+
+	var responses []*pb.TransformResponse
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		responses = append(responses, resp)
+	}
+
+	if assert.Len(t, responses, len(requests)) {
+		assert.Equal(t, "HELLO", responses[0].V)
+		assert.Equal(t, ErrUnknownOp.Error(), responses[1].Err)
+		assert.Equal(t, int64(5), responses[2].N)
+		assert.Contains(t, responses[3].Err, "exceeds")
+		assert.Equal(t, "Hello World", responses[4].V)
+	}
+}
+
+func TestGRPCTransformStreamUnauthenticated(t *testing.T) {
+	conn, stop := dialTestGRPCServer(t, makeSvc())
+	defer stop()
+
+	stream, err := pb.NewStringServiceClient(conn).Transform(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = stream.Send(&pb.TransformRequest{Op: OpUppercase, S: "hello"})
+
+	_, err = stream.Recv()
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
@@ -3,8 +3,10 @@ package main
 import (
 	"context"
 	"encoding/json"
+	"expvar"
 	"github.com/dgrijalva/jwt-go"
 	gokitjwt "github.com/go-kit/kit/auth/jwt"
+	"github.com/go-kit/kit/endpoint"
 	httptransport "github.com/go-kit/kit/transport/http"
 	"github.com/gorilla/mux"
 	"net/http"
@@ -14,7 +16,25 @@ import (
 
 func decodeUppercaseRequest(_ context.Context, r *http.Request) (interface{}, error) {
 	var request uppercaseRequest
-	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+	if err := decodeJSONBody(r, &request); err != nil {
+		return nil, err
+	}
+
+	return request, nil
+}
+
+func decodeLowercaseRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var request lowercaseRequest
+	if err := decodeJSONBody(r, &request); err != nil {
+		return nil, err
+	}
+
+	return request, nil
+}
+
+func decodeTitleCaseRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var request titleCaseRequest
+	if err := decodeJSONBody(r, &request); err != nil {
 		return nil, err
 	}
 
@@ -23,7 +43,187 @@ func decodeUppercaseRequest(_ context.Context, r *http.Request) (interface{}, er
 
 func decodeCountRequest(_ context.Context, r *http.Request) (interface{}, error) {
 	var request countRequest
-	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+	if err := decodeJSONBody(r, &request); err != nil {
+		return nil, err
+	}
+
+	return request, nil
+}
+
+func decodeNormalizeRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var request normalizeRequest
+	if err := decodeJSONBody(r, &request); err != nil {
+		return nil, err
+	}
+
+	return request, nil
+}
+
+func decodeAnalyzeRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var request analyzeRequest
+	if err := decodeJSONBody(r, &request); err != nil {
+		return nil, err
+	}
+
+	return request, nil
+}
+
+func decodeBatchRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var request batchRequest
+	if err := decodeJSONBody(r, &request); err != nil {
+		return nil, err
+	}
+
+	return request, nil
+}
+
+func decodePipelineRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var request pipelineRequest
+	if err := decodeJSONBody(r, &request); err != nil {
+		return nil, err
+	}
+
+	return request, nil
+}
+
+func decodeReplaceRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var request replaceRequest
+	if err := decodeJSONBody(r, &request); err != nil {
+		return nil, err
+	}
+
+	return request, nil
+}
+
+func decodeExtractRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var request extractRequest
+	if err := decodeJSONBody(r, &request); err != nil {
+		return nil, err
+	}
+
+	return request, nil
+}
+
+func decodeEncodeStringRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var request encodeStringRequest
+	if err := decodeJSONBody(r, &request); err != nil {
+		return nil, err
+	}
+
+	return request, nil
+}
+
+func decodeDecodeStringRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var request decodeStringRequest
+	if err := decodeJSONBody(r, &request); err != nil {
+		return nil, err
+	}
+
+	return request, nil
+}
+
+func decodeHashRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var request hashRequest
+	if err := decodeJSONBody(r, &request); err != nil {
+		return nil, err
+	}
+
+	return request, nil
+}
+
+func decodeCompareRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var request compareRequest
+	if err := decodeJSONBody(r, &request); err != nil {
+		return nil, err
+	}
+
+	return request, nil
+}
+
+func decodeDiffRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var request diffRequest
+	if err := decodeJSONBody(r, &request); err != nil {
+		return nil, err
+	}
+
+	return request, nil
+}
+
+func decodeSlugifyRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var request slugifyRequest
+	if err := decodeJSONBody(r, &request); err != nil {
+		return nil, err
+	}
+
+	return request, nil
+}
+
+func decodeTokenizeRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var request tokenizeRequest
+	if err := decodeJSONBody(r, &request); err != nil {
+		return nil, err
+	}
+
+	return request, nil
+}
+
+func decodeRenderRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var request renderRequest
+	if err := decodeJSONBody(r, &request); err != nil {
+		return nil, err
+	}
+
+	return request, nil
+}
+
+func decodeRedactRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var request redactRequest
+	if err := decodeJSONBody(r, &request); err != nil {
+		return nil, err
+	}
+
+	return request, nil
+}
+
+func decodeDetectLanguageRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var request detectLanguageRequest
+	if err := decodeJSONBody(r, &request); err != nil {
+		return nil, err
+	}
+
+	return request, nil
+}
+
+func decodeTruncateRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var request truncateRequest
+	if err := decodeJSONBody(r, &request); err != nil {
+		return nil, err
+	}
+
+	return request, nil
+}
+
+func decodeWrapRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var request wrapRequest
+	if err := decodeJSONBody(r, &request); err != nil {
+		return nil, err
+	}
+
+	return request, nil
+}
+
+func decodeSubmitJobRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var request submitJobRequest
+	if err := decodeJSONBody(r, &request); err != nil {
+		return nil, err
+	}
+
+	return request, nil
+}
+
+func decodeJobRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	request := jobRequest{ID: mux.Vars(r)["id"]}
+	if err := request.validate(); err != nil {
 		return nil, err
 	}
 
@@ -36,7 +236,7 @@ func decodeHealthRequest(_ context.Context, _ *http.Request) (interface{}, error
 
 func decodeAuthRequest(_ context.Context, r *http.Request) (interface{}, error) {
 	var request authRequest
-	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+	if err := decodeJSONBody(r, &request); err != nil {
 		return nil, err
 	}
 	return request, nil
@@ -48,15 +248,57 @@ func encodeResponse(_ context.Context, w http.ResponseWriter, response interface
 }
 
 func encodeError(_ context.Context, err error, w http.ResponseWriter) {
+	body, code := errorMessage(err)
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
-	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+	w.WriteHeader(code)
+	_, _ = w.Write(append(body, '\n'))
+}
+
+// jwtErrors are the failures of gokitjwt.NewParser, which do not carry a status.
+var jwtErrors = map[error]bool{
+	gokitjwt.ErrTokenContextMissing:     true,
+	gokitjwt.ErrTokenInvalid:            true,
+	gokitjwt.ErrTokenExpired:            true,
+	gokitjwt.ErrTokenMalformed:          true,
+	gokitjwt.ErrTokenNotActive:          true,
+	gokitjwt.ErrUnexpectedSigningMethod: true,
+}
+
+// errorMessage returns the JSON body and status of err, shared by every
+// transport: the status of a StatusCoder, 401 for JWT errors and 500 for
+// anything else.
+func errorMessage(err error) ([]byte, int) {
+	body := map[string]interface{}{
 		"error": err.Error(),
+	}
+	if fields, ok := err.(validationError); ok {
+		body["fields"] = []fieldError(fields)
+	}
+
+	code := http.StatusInternalServerError
+	if sc, ok := err.(httptransport.StatusCoder); ok {
+		code = sc.StatusCode()
+	} else if jwtErrors[err] {
+		code = http.StatusUnauthorized
+	}
+	b, _ := json.Marshal(body)
+	return b, code
+}
+
+// authenticatedHTTP serves next only to requests with a valid bearer token.
+func authenticatedHTTP(parser endpoint.Middleware, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, err := authenticateContext(gokitjwt.HTTPToContext()(r.Context(), r), parser); err != nil {
+			encodeError(r.Context(), err, w)
+			return
+		}
+		next.ServeHTTP(w, r)
 	})
 }
 
 // HTTP Handler
 
-func makeHTTPHandler(svc StringService) http.Handler {
+func makeHTTPHandler(svc StringService, jobs JobService) http.Handler {
 	kf := func(token *jwt.Token) (interface{}, error) {
 		return authConfig.key, nil
 	}
@@ -68,35 +310,225 @@ func makeHTTPHandler(svc StringService) http.Handler {
 		httptransport.ServerBefore(gokitjwt.HTTPToContext()),
 	}
 
+	recovered := recoveryMiddleware(logger, panicsTotal)
+	idempotency := newIdempotencyStore(idempotencyWindow, idempotencyEntries, idempotencyBytes)
+	authIdempotency := newIdempotencyStore(authIdempotencyWindow, idempotencyEntries, idempotencyBytes)
+
+	// Streaming routes read unbounded bodies, every other route is size-limited.
+	root := mux.NewRouter()
+	root.Use(requestIDHTTP, recoveryHTTP(logger, panicsTotal))
+
+	root.Methods("POST").Path("/stream/uppercase").Handler(
+		makeStreamUppercaseHandler(uncached(svc), gokitjwt.NewParser(kf, jwt.SigningMethodHS256, clf)),
+	)
+
+	root.Methods("POST").Path("/stream/count").Handler(
+		makeStreamCountHandler(uncached(svc), gokitjwt.NewParser(kf, jwt.SigningMethodHS256, clf)),
+	)
+
 	r := mux.NewRouter()
+	r.Use(limitBody(limits.maxBodyBytes))
+	root.PathPrefix("/").Handler(r)
 
 	r.Methods("POST").Path("/uppercase").Handler(httptransport.NewServer(
-		gokitjwt.NewParser(kf, jwt.SigningMethodHS256, clf)(makeUppercaseEndpoint(svc)),
+		recovered(gokitjwt.NewParser(kf, jwt.SigningMethodHS256, clf)(makeUppercaseEndpoint(svc))),
 		decodeUppercaseRequest,
 		encodeResponse,
 		options...,
 	))
 
+	r.Methods("POST").Path("/lowercase").Handler(httptransport.NewServer(
+		recovered(gokitjwt.NewParser(kf, jwt.SigningMethodHS256, clf)(makeLowercaseEndpoint(svc))),
+		decodeLowercaseRequest,
+		encodeResponse,
+		options...,
+	))
+
+	r.Methods("POST").Path("/titlecase").Handler(httptransport.NewServer(
+		recovered(gokitjwt.NewParser(kf, jwt.SigningMethodHS256, clf)(makeTitleCaseEndpoint(svc))),
+		decodeTitleCaseRequest,
+		encodeResponse,
+		options...,
+	))
+
 	r.Methods("POST").Path("/count").Handler(httptransport.NewServer(
-		gokitjwt.NewParser(kf, jwt.SigningMethodHS256, clf)(makeCountEndpoint(svc)),
+		recovered(gokitjwt.NewParser(kf, jwt.SigningMethodHS256, clf)(makeCountEndpoint(svc))),
 		decodeCountRequest,
 		encodeResponse,
 		options...,
 	))
 
+	r.Methods("POST").Path("/normalize").Handler(httptransport.NewServer(
+		recovered(gokitjwt.NewParser(kf, jwt.SigningMethodHS256, clf)(makeNormalizeEndpoint(svc))),
+		decodeNormalizeRequest,
+		encodeResponse,
+		options...,
+	))
+
+	r.Methods("POST").Path("/analyze").Handler(httptransport.NewServer(
+		recovered(gokitjwt.NewParser(kf, jwt.SigningMethodHS256, clf)(makeAnalyzeEndpoint(svc))),
+		decodeAnalyzeRequest,
+		encodeResponse,
+		options...,
+	))
+
+	r.Methods("POST").Path("/batch").Handler(idempotentHTTP(idempotency, bearerPrincipal, notServerError)(httptransport.NewServer(
+		recovered(gokitjwt.NewParser(kf, jwt.SigningMethodHS256, clf)(makeBatchEndpoint(svc))),
+		decodeBatchRequest,
+		encodeResponse,
+		options...,
+	)))
+
+	r.Methods("POST").Path("/pipeline").Handler(httptransport.NewServer(
+		recovered(gokitjwt.NewParser(kf, jwt.SigningMethodHS256, clf)(makePipelineEndpoint(svc))),
+		decodePipelineRequest,
+		encodeResponse,
+		options...,
+	))
+
+	r.Methods("POST").Path("/replace").Handler(httptransport.NewServer(
+		recovered(gokitjwt.NewParser(kf, jwt.SigningMethodHS256, clf)(makeReplaceEndpoint(svc))),
+		decodeReplaceRequest,
+		encodeResponse,
+		options...,
+	))
+
+	r.Methods("POST").Path("/extract").Handler(httptransport.NewServer(
+		recovered(gokitjwt.NewParser(kf, jwt.SigningMethodHS256, clf)(makeExtractEndpoint(svc))),
+		decodeExtractRequest,
+		encodeResponse,
+		options...,
+	))
+
+	r.Methods("POST").Path("/encode").Handler(httptransport.NewServer(
+		recovered(gokitjwt.NewParser(kf, jwt.SigningMethodHS256, clf)(makeEncodeEndpoint(svc))),
+		decodeEncodeStringRequest,
+		encodeResponse,
+		options...,
+	))
+
+	r.Methods("POST").Path("/decode").Handler(httptransport.NewServer(
+		recovered(gokitjwt.NewParser(kf, jwt.SigningMethodHS256, clf)(makeDecodeEndpoint(svc))),
+		decodeDecodeStringRequest,
+		encodeResponse,
+		options...,
+	))
+
+	r.Methods("POST").Path("/hash").Handler(httptransport.NewServer(
+		recovered(gokitjwt.NewParser(kf, jwt.SigningMethodHS256, clf)(makeHashEndpoint(svc))),
+		decodeHashRequest,
+		encodeResponse,
+		options...,
+	))
+
+	r.Methods("POST").Path("/compare").Handler(httptransport.NewServer(
+		recovered(gokitjwt.NewParser(kf, jwt.SigningMethodHS256, clf)(makeCompareEndpoint(svc))),
+		decodeCompareRequest,
+		encodeResponse,
+		options...,
+	))
+
+	r.Methods("POST").Path("/diff").Handler(httptransport.NewServer(
+		recovered(gokitjwt.NewParser(kf, jwt.SigningMethodHS256, clf)(makeDiffEndpoint(svc))),
+		decodeDiffRequest,
+		encodeResponse,
+		options...,
+	))
+
+	r.Methods("POST").Path("/slugify").Handler(httptransport.NewServer(
+		recovered(gokitjwt.NewParser(kf, jwt.SigningMethodHS256, clf)(makeSlugifyEndpoint(svc))),
+		decodeSlugifyRequest,
+		encodeResponse,
+		options...,
+	))
+
+	r.Methods("POST").Path("/tokenize").Handler(httptransport.NewServer(
+		recovered(gokitjwt.NewParser(kf, jwt.SigningMethodHS256, clf)(makeTokenizeEndpoint(svc))),
+		decodeTokenizeRequest,
+		encodeResponse,
+		options...,
+	))
+
+	r.Methods("POST").Path("/render").Handler(httptransport.NewServer(
+		recovered(gokitjwt.NewParser(kf, jwt.SigningMethodHS256, clf)(makeRenderEndpoint(svc))),
+		decodeRenderRequest,
+		encodeResponse,
+		options...,
+	))
+
+	r.Methods("POST").Path("/redact").Handler(httptransport.NewServer(
+		recovered(gokitjwt.NewParser(kf, jwt.SigningMethodHS256, clf)(makeRedactEndpoint(svc))),
+		decodeRedactRequest,
+		encodeResponse,
+		options...,
+	))
+
+	r.Methods("POST").Path("/detect").Handler(httptransport.NewServer(
+		recovered(gokitjwt.NewParser(kf, jwt.SigningMethodHS256, clf)(makeDetectLanguageEndpoint(svc))),
+		decodeDetectLanguageRequest,
+		encodeResponse,
+		options...,
+	))
+
+	r.Methods("POST").Path("/truncate").Handler(httptransport.NewServer(
+		recovered(gokitjwt.NewParser(kf, jwt.SigningMethodHS256, clf)(makeTruncateEndpoint(svc))),
+		decodeTruncateRequest,
+		encodeResponse,
+		options...,
+	))
+
+	r.Methods("POST").Path("/wrap").Handler(httptransport.NewServer(
+		recovered(gokitjwt.NewParser(kf, jwt.SigningMethodHS256, clf)(makeWrapEndpoint(svc))),
+		decodeWrapRequest,
+		encodeResponse,
+		options...,
+	))
+
+	r.Methods("POST").Path("/jobs").Handler(idempotentHTTP(idempotency, bearerPrincipal, notServerError)(httptransport.NewServer(
+		recovered(gokitjwt.NewParser(kf, jwt.SigningMethodHS256, clf)(makeSubmitJobEndpoint(jobs))),
+		decodeSubmitJobRequest,
+		encodeResponse,
+		options...,
+	)))
+
+	r.Methods("GET").Path("/jobs/{id}").Handler(httptransport.NewServer(
+		recovered(gokitjwt.NewParser(kf, jwt.SigningMethodHS256, clf)(makeGetJobEndpoint(jobs))),
+		decodeJobRequest,
+		encodeResponse,
+		options...,
+	))
+
+	r.Methods("DELETE").Path("/jobs/{id}").Handler(httptransport.NewServer(
+		recovered(gokitjwt.NewParser(kf, jwt.SigningMethodHS256, clf)(makeCancelJobEndpoint(jobs))),
+		decodeJobRequest,
+		encodeResponse,
+		options...,
+	))
+
+	r.Methods("GET").Path("/jobs/{id}/deliveries").Handler(httptransport.NewServer(
+		recovered(gokitjwt.NewParser(kf, jwt.SigningMethodHS256, clf)(makeJobDeliveriesEndpoint(jobs))),
+		decodeJobRequest,
+		encodeResponse,
+		options...,
+	))
+
 	r.Methods("GET").Path("/health").Handler(httptransport.NewServer(
-		makeHealthEndpoint(svc),
+		recovered(makeHealthEndpoint(svc)),
 		decodeHealthRequest,
 		encodeResponse,
 		options...,
 	))
 
-	r.Methods("POST").Path("/auth").Handler(httptransport.NewServer(
-		makeAuthEndpoint(svc),
+	r.Methods("POST").Path("/auth").Handler(idempotentHTTP(authIdempotency, authPrincipal, succeeded)(httptransport.NewServer(
+		recovered(makeAuthEndpoint(svc)),
 		decodeAuthRequest,
 		encodeResponse,
 		options...,
-	))
+	)))
+
+	r.Methods("GET").Path("/debug/vars").Handler(
+		authenticatedHTTP(gokitjwt.NewParser(kf, jwt.SigningMethodHS256, clf), expvar.Handler()),
+	)
 
-	return r
+	return root
 }
@@ -0,0 +1,183 @@
+package main
+
+import (
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"unicode/utf8"
+
+	gokitjwt "github.com/go-kit/kit/auth/jwt"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/rivo/uniseg"
+)
+
+// streamChunkSize is how much of the body is read and transformed at a time.
+const streamChunkSize = 32 << 10
+
+// streamErrorTrailer reports errors that happen after the response has started.
+const streamErrorTrailer = "X-Stream-Error"
+
+var ErrInvalidUTF8 = errors.New("invalid UTF-8")
+
+// readChunks reads r incrementally and calls fn with chunks that never split
+// a UTF-8 sequence or, unless it is longer than size, an extended grapheme
+// cluster. Only the incomplete tail of the previous read is kept between calls.
+func readChunks(r io.Reader, size int, fn func(string) error) error {
+	buf := make([]byte, size)
+	var carry []byte
+	for {
+		n, err := r.Read(buf)
+		if n > 0 {
+			data := append(carry, buf[:n]...)
+			complete, rest := splitIncomplete(data)
+			if len(rest) > size {
+				cut := len(rest) - partialRuneLen(rest)
+				complete, rest = data[:len(complete)+cut], rest[cut:]
+			}
+			if !utf8.Valid(complete) {
+				return ErrInvalidUTF8
+			}
+			if len(complete) > 0 {
+				if ferr := fn(string(complete)); ferr != nil {
+					return ferr
+				}
+			}
+			carry = append([]byte(nil), rest...)
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(carry) > 0 {
+		if !utf8.Valid(carry) {
+			return ErrInvalidUTF8
+		}
+		return fn(string(carry))
+	}
+	return nil
+}
+
+// partialRuneLen returns the length of a trailing incomplete UTF-8 sequence.
+func partialRuneLen(data []byte) int {
+	for i := len(data) - 1; i >= 0 && i >= len(data)-utf8.UTFMax; i-- {
+		if utf8.RuneStart(data[i]) {
+			if !utf8.FullRune(data[i:]) {
+				return len(data) - i
+			}
+			return 0
+		}
+	}
+	return 0
+}
+
+// splitIncomplete cuts data before its last grapheme cluster, which the next
+// read may still extend, or before a trailing partial UTF-8 sequence.
+func splitIncomplete(data []byte) (complete []byte, rest []byte) {
+	last, state := 0, -1
+	for rest := data[:len(data)-partialRuneLen(data)]; len(rest) > 0; {
+		var cluster []byte
+		cluster, rest, _, state = uniseg.FirstGraphemeCluster(rest, state)
+		if len(rest) > 0 {
+			last += len(cluster)
+		}
+	}
+
+	return data[:last], data[last:]
+}
+
+func checkStreamContentType(r *http.Request) error {
+	ct := r.Header.Get("Content-Type")
+	if ct == "" {
+		return nil
+	}
+	mediaType, _, err := mime.ParseMediaType(ct)
+	if err != nil || mediaType != "text/plain" {
+		return validationError{{"Content-Type", "must be text/plain"}}
+	}
+	return nil
+}
+
+// makeStreamUppercaseHandler uppercases a text/plain body chunk by chunk and
+// streams the result back. The locale is taken from the query string.
+func makeStreamUppercaseHandler(svc StringService, parser endpoint.Middleware) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, err := authenticateContext(gokitjwt.HTTPToContext()(r.Context(), r), parser)
+		if err != nil {
+			encodeError(r.Context(), err, w)
+			return
+		}
+		locale := r.URL.Query().Get("locale")
+		if err := validateFields(checkLocale("locale", locale)); err != nil {
+			encodeError(ctx, err, w)
+			return
+		}
+		if err := checkStreamContentType(r); err != nil {
+			encodeError(ctx, err, w)
+			return
+		}
+
+		// HTTP/1.x servers stop reading the body once the response starts
+		// unless full duplex is enabled.
+		rc := http.NewResponseController(w)
+		_ = rc.EnableFullDuplex()
+
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		w.Header().Set("Trailer", streamErrorTrailer)
+
+		err = readChunks(r.Body, streamChunkSize, func(chunk string) error {
+			v, err := svc.Uppercase(chunk, locale)
+			if err != nil {
+				return err
+			}
+			if _, err := io.WriteString(w, v); err != nil {
+				return err
+			}
+			return rc.Flush()
+		})
+		if err != nil {
+			w.Header().Set(streamErrorTrailer, err.Error())
+		}
+	})
+}
+
+// makeStreamCountHandler counts a text/plain body chunk by chunk; the unit is
+// taken from the query string.
+func makeStreamCountHandler(svc StringService, parser endpoint.Middleware) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, err := authenticateContext(gokitjwt.HTTPToContext()(r.Context(), r), parser)
+		if err != nil {
+			encodeError(r.Context(), err, w)
+			return
+		}
+		unit := r.URL.Query().Get("unit")
+		if err := validateFields(checkOneOf("unit", unit, CountBytes, CountRunes, CountGraphemes)); err != nil {
+			encodeError(ctx, err, w)
+			return
+		}
+		if err := checkStreamContentType(r); err != nil {
+			encodeError(ctx, err, w)
+			return
+		}
+
+		var total int64
+		err = readChunks(r.Body, streamChunkSize, func(chunk string) error {
+			n, err := svc.Count(chunk, unit)
+			total += n
+			return err
+		})
+		if err == ErrInvalidUTF8 {
+			err = validationError{{"body", err.Error()}}
+		}
+		if err != nil {
+			encodeError(ctx, err, w)
+			return
+		}
+
+		_ = encodeResponse(ctx, w, countResponse{total, ""})
+	})
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"testing/iotest"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const streamText = "straße café ﬁx 👍🏽 é 🇺🇦 привет\n"
+
+func TestReadChunksKeepsSequencesWhole(t *testing.T) {
+	var chunks []string
+	err := readChunks(iotest.OneByteReader(strings.NewReader(streamText)), 16, func(chunk string) error {
+		chunks = append(chunks, chunk)
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, streamText, strings.Join(chunks, ""))
+	for _, chunk := range chunks {
+		assert.True(t, utf8.ValidString(chunk), "%q", chunk)
+	}
+	assert.Contains(t, chunks, "👍🏽")
+	assert.Contains(t, chunks, "🇺🇦")
+	assert.Contains(t, chunks, "é")
+}
+
+func TestReadChunksInvalidUTF8(t *testing.T) {
+	err := readChunks(strings.NewReader("abc\xe2\x82"), 2, func(string) error { return nil })
+	assert.Equal(t, ErrInvalidUTF8, err)
+}
+
+func postStream(t *testing.T, srv *httptest.Server, path string, body string) *http.Response {
+	req, _ := http.NewRequest("POST", srv.URL+path, iotest.HalfReader(strings.NewReader(body)))
+	req.Header.Set("Authorization", "Bearer "+testToken(t))
+	req.Header.Set("Content-Type", "text/plain; charset=utf-8")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp
+}
+
+func TestHTTPStreamUppercase(t *testing.T) {
+	svc := makeSvc()
+	srv := httptest.NewServer(makeHTTPHandler(svc, makeJobs(svc)))
+	defer srv.Close()
+
+	body := strings.Repeat(streamText, streamChunkSize/len(streamText)*3)
+	resp := postStream(t, srv, "/stream/uppercase", body)
+	defer resp.Body.Close()
+
+	out, _ := ioutil.ReadAll(resp.Body)
+	want, _ := stringService{}.Uppercase(body, "")
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, want, string(out))
+	assert.Empty(t, resp.Trailer.Get(streamErrorTrailer))
+}
+
+func TestHTTPStreamCount(t *testing.T) {
+	svc := makeSvc()
+	srv := httptest.NewServer(makeHTTPHandler(svc, makeJobs(svc)))
+	defer srv.Close()
+
+	body := strings.Repeat(streamText, streamChunkSize/len(streamText)*3)
+	for _, unit := range []string{CountBytes, CountRunes, CountGraphemes} {
+		resp := postStream(t, srv, "/stream/count?unit="+unit, body)
+
+		var response countResponse
+		_ = json.NewDecoder(resp.Body).Decode(&response)
+		_ = resp.Body.Close()
+
+		want, _ := stringService{}.Count(body, unit)
+		assert.Equal(t, want, response.V, unit)
+	}
+
+	resp := postStream(t, srv, "/stream/count", "abc\xff")
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
@@ -0,0 +1,153 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/dgrijalva/jwt-go"
+	gokitjwt "github.com/go-kit/kit/auth/jwt"
+	natstransport "github.com/go-kit/kit/transport/nats"
+	"github.com/nats-io/nats.go"
+)
+
+// NATS subjects served in the natsQueueGroup queue group, so that each
+// request is handled by a single instance.
+const (
+	natsSubjectUppercase = "stringsvc.uppercase"
+	natsSubjectCount     = "stringsvc.count"
+	natsSubjectAuth      = "stringsvc.auth"
+	natsQueueGroup       = "stringsvc"
+	natsStatusHeader     = "Status-Code"
+)
+
+func decodeUppercaseNATSRequest(_ context.Context, msg *nats.Msg) (interface{}, error) {
+	var request uppercaseRequest
+	if err := decodeNATSMsg(msg, &request); err != nil {
+		return nil, err
+	}
+
+	return request, nil
+}
+
+func decodeCountNATSRequest(_ context.Context, msg *nats.Msg) (interface{}, error) {
+	var request countRequest
+	if err := decodeNATSMsg(msg, &request); err != nil {
+		return nil, err
+	}
+
+	return request, nil
+}
+
+func decodeAuthNATSRequest(_ context.Context, msg *nats.Msg) (interface{}, error) {
+	var request authRequest
+	if err := decodeNATSMsg(msg, &request); err != nil {
+		return nil, err
+	}
+
+	return request, nil
+}
+
+func decodeNATSMsg(msg *nats.Msg, v validator) error {
+	if int64(len(msg.Data)) > limits.maxBodyBytes {
+		return validationError{{"body", fmt.Sprintf("exceeds %d bytes", limits.maxBodyBytes)}}
+	}
+	return decodeJSON(msg.Data, v)
+}
+
+// encodeNATSError replies with the body encodeError writes over HTTP and its
+// status code in the Status-Code header.
+func encodeNATSError(_ context.Context, err error, reply string, nc *nats.Conn) {
+	body, code := errorMessage(err)
+	msg := nats.NewMsg(reply)
+	msg.Header.Set(natsStatusHeader, strconv.Itoa(code))
+	msg.Data = body
+	if err := nc.PublishMsg(msg); err != nil {
+		_ = logger.Log("transport", "nats", "err", err)
+	}
+}
+
+// natsToContext moves the bearer token of the Authorization header and the
+// request ID into the context.
+func natsToContext(ctx context.Context, msg *nats.Msg) context.Context {
+	ctx = contextWithRequestID(ctx, msg.Header.Get(requestIDHeader))
+	parts := strings.Fields(msg.Header.Get("Authorization"))
+	if len(parts) != 2 || !strings.EqualFold(parts[0], "Bearer") {
+		return ctx
+	}
+	return context.WithValue(ctx, gokitjwt.JWTTokenContextKey, parts[1])
+}
+
+// recoveryNATS catches panics raised by decoders and encoders outside the
+// endpoint, which would otherwise stop the process.
+func recoveryNATS(nc *nats.Conn, handler nats.MsgHandler) nats.MsgHandler {
+	return func(msg *nats.Msg) {
+		defer func() {
+			if r := recover(); r != nil {
+				ctx := contextWithRequestID(context.Background(), msg.Header.Get(requestIDHeader))
+				err := logPanic(ctx, logger, panicsTotal, "nats", r)
+				if msg.Reply != "" {
+					encodeNATSError(ctx, err, msg.Reply, nc)
+				}
+			}
+		}()
+
+		handler(msg)
+	}
+}
+
+// NATS Handler
+
+func makeNATSSubscribers(svc StringService) map[string]*natstransport.Subscriber {
+	kf := func(token *jwt.Token) (interface{}, error) {
+		return authConfig.key, nil
+	}
+	clf := func() jwt.Claims {
+		return &customClaims{}
+	}
+	options := []natstransport.SubscriberOption{
+		natstransport.SubscriberErrorEncoder(encodeNATSError),
+		natstransport.SubscriberBefore(natsToContext),
+	}
+
+	recovered := recoveryMiddleware(logger, panicsTotal)
+	authenticated := authenticateMiddleware(gokitjwt.NewParser(kf, jwt.SigningMethodHS256, clf))
+
+	return map[string]*natstransport.Subscriber{
+		natsSubjectUppercase: natstransport.NewSubscriber(
+			recovered(authenticated(makeUppercaseEndpoint(svc))),
+			decodeUppercaseNATSRequest,
+			natstransport.EncodeJSONResponse,
+			options...,
+		),
+		natsSubjectCount: natstransport.NewSubscriber(
+			recovered(authenticated(makeCountEndpoint(svc))),
+			decodeCountNATSRequest,
+			natstransport.EncodeJSONResponse,
+			options...,
+		),
+		natsSubjectAuth: natstransport.NewSubscriber(
+			recovered(makeAuthEndpoint(svc)),
+			decodeAuthNATSRequest,
+			natstransport.EncodeJSONResponse,
+			options...,
+		),
+	}
+}
+
+// serveNATS subscribes svc to its subjects on nc.
+func serveNATS(nc *nats.Conn, svc StringService) ([]*nats.Subscription, error) {
+	var subs []*nats.Subscription
+	for subject, subscriber := range makeNATSSubscribers(svc) {
+		sub, err := nc.QueueSubscribe(subject, natsQueueGroup, recoveryNATS(nc, subscriber.ServeMsg(nc)))
+		if err != nil {
+			for _, s := range subs {
+				_ = s.Unsubscribe()
+			}
+			return nil, err
+		}
+		subs = append(subs, sub)
+	}
+	return subs, nil
+}
@@ -0,0 +1,141 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	gokitjwt "github.com/go-kit/kit/auth/jwt"
+	natsserver "github.com/nats-io/nats-server/v2/server"
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+)
+
+// connectTestNATS starts an embedded NATS server serving svc and connects to it.
+func connectTestNATS(t *testing.T, svc StringService) (*nats.Conn, func()) {
+	ns, err := natsserver.NewServer(&natsserver.Options{Host: "127.0.0.1", Port: -1, NoLog: true, NoSigs: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	go ns.Start()
+	if !ns.ReadyForConnections(5 * time.Second) {
+		t.Fatal("nats server not ready")
+	}
+
+	serverConn, err := nats.Connect(ns.ClientURL())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := serveNATS(serverConn, svc); err != nil {
+		t.Fatal(err)
+	}
+	nc, err := nats.Connect(ns.ClientURL())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return nc, func() {
+		nc.Close()
+		serverConn.Close()
+		ns.Shutdown()
+	}
+}
+
+func natsRequest(t *testing.T, nc *nats.Conn, subject string, token string, request interface{}, response interface{}) *nats.Msg {
+	msg := nats.NewMsg(subject)
+	msg.Data, _ = json.Marshal(request)
+	if token != "" {
+		msg.Header.Set("Authorization", "Bearer "+token)
+	}
+	reply, err := nc.RequestMsg(msg, 5*time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(reply.Data, response); err != nil {
+		t.Fatal(err)
+	}
+	return reply
+}
+
+func TestNATSTransport(t *testing.T) {
+	nc, stop := connectTestNATS(t, makeSvc())
+	defer stop()
+
+	var auth authResponse
+	natsRequest(t, nc, natsSubjectAuth, "", authRequest{Username: "user1", Password: "passwordOne"}, &auth)
+	assert.NotEmpty(t, auth.Token)
+
+	var upper uppercaseResponse
+	natsRequest(t, nc, natsSubjectUppercase, auth.Token, uppercaseRequest{S: "hello, nats"}, &upper)
+	assert.Equal(t, "HELLO, NATS", upper.V)
+
+	var count countResponse
+	natsRequest(t, nc, natsSubjectCount, auth.Token, countRequest{S: "👍🏽", Unit: CountGraphemes}, &count)
+	assert.Equal(t, int64(1), count.V)
+
+	var failure map[string]interface{}
+	reply := natsRequest(t, nc, natsSubjectUppercase, "", uppercaseRequest{S: "hello"}, &failure)
+	assert.Equal(t, strconv.Itoa(http.StatusUnauthorized), reply.Header.Get(natsStatusHeader))
+
+	reply = natsRequest(t, nc, natsSubjectCount, auth.Token, map[string]string{"s": "a", "unit": "words"}, &failure)
+	assert.Equal(t, strconv.Itoa(http.StatusBadRequest), reply.Header.Get(natsStatusHeader))
+	assert.NotEmpty(t, failure["fields"])
+
+	reply = natsRequest(t, nc, natsSubjectAuth, "", authRequest{Username: "user1", Password: "guess"}, &failure)
+	assert.Equal(t, strconv.Itoa(http.StatusUnauthorized), reply.Header.Get(natsStatusHeader))
+	assert.Equal(t, "incorrect credentials", failure["error"])
+}
+
+func TestNATSQueueGroup(t *testing.T) {
+	nc, stop := connectTestNATS(t, makeSvc())
+	defer stop()
+
+	// A second instance joins the queue group; each request is answered once.
+	if _, err := serveNATS(nc, makeSvc()); err != nil {
+		t.Fatal(err)
+	}
+
+	inbox := nats.NewInbox()
+	replies, err := nc.SubscribeSync(inbox)
+	if err != nil {
+		t.Fatal(err)
+	}
+	request, _ := json.Marshal(authRequest{Username: "user1", Password: "passwordOne"})
+	for i := 0; i < 10; i++ {
+		assert.NoError(t, nc.PublishRequest(natsSubjectAuth, inbox, request))
+	}
+
+	for i := 0; i < 10; i++ {
+		_, err := replies.NextMsg(5 * time.Second)
+		assert.NoError(t, err)
+	}
+	_, err = replies.NextMsg(100 * time.Millisecond)
+	assert.Equal(t, nats.ErrTimeout, err)
+}
+
+func TestErrorStatusIsSharedWithHTTP(t *testing.T) {
+	for _, err := range []error{
+		gokitjwt.ErrTokenContextMissing,
+		unauthenticatedError{gokitjwt.ErrTokenExpired},
+		validationError{{"s", "is required"}},
+		idempotencyConflictError{},
+		credentialsError{},
+		errors.New("boom"),
+	} {
+		rec := httptest.NewRecorder()
+		encodeError(context.Background(), err, rec)
+		body, code := errorMessage(err)
+		assert.Equal(t, code, rec.Code, err.Error())
+		assert.JSONEq(t, string(body), rec.Body.String(), err.Error())
+	}
+
+	_, code := errorMessage(gokitjwt.ErrTokenContextMissing)
+	assert.Equal(t, http.StatusUnauthorized, code)
+	_, code = errorMessage(errors.New("boom"))
+	assert.Equal(t, http.StatusInternalServerError, code)
+}
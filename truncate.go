@@ -0,0 +1,194 @@
+package main
+
+import (
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/rivo/uniseg"
+)
+
+// CountWidth measures strings in terminal columns, East Asian wide
+// characters and most emoji taking two.
+const CountWidth = "width"
+
+// Limits on truncation and wrapping lengths
+const maxTextWidth = 1 << 16
+
+var (
+	ErrEllipsisTooLong = errors.New("ellipsis longer than max")
+	ErrLengthRange     = errors.New("length out of range")
+)
+
+// TruncateOptions configures Truncate. Max is measured in Unit, bytes by
+// default; Ellipsis is appended when s is cut and counts towards Max.
+type TruncateOptions struct {
+	Unit     string
+	Max      int
+	Ellipsis string
+}
+
+// measure returns the length of s in unit.
+func measure(s string, unit string) int {
+	switch unit {
+	case CountRunes:
+		return utf8.RuneCountInString(s)
+	case CountGraphemes:
+		return uniseg.GraphemeClusterCount(s)
+	case CountWidth:
+		return uniseg.StringWidth(s)
+	}
+	return len(s)
+}
+
+// truncateString cuts s to opts.Max, always between grapheme clusters so
+// emoji sequences and combining marks are never split.
+func truncateString(s string, opts TruncateOptions) (string, error) {
+	switch opts.Unit {
+	case "", CountBytes, CountRunes, CountGraphemes, CountWidth:
+	default:
+		return "", ErrUnknownUnit
+	}
+	if opts.Max < 0 || opts.Max > maxTextWidth {
+		return "", ErrLengthRange
+	}
+	if measure(s, opts.Unit) <= opts.Max {
+		return s, nil
+	}
+	budget := opts.Max - measure(opts.Ellipsis, opts.Unit)
+	if budget < 0 {
+		return "", ErrEllipsisTooLong
+	}
+
+	end, used, state := 0, 0, -1
+	for rest := s; rest != ""; {
+		var cluster string
+		cluster, rest, _, state = uniseg.FirstGraphemeClusterInString(rest, state)
+		n := measure(cluster, opts.Unit)
+		if opts.Unit == CountGraphemes {
+			n = 1
+		}
+		if used+n > budget {
+			break
+		}
+		used += n
+		end += len(cluster)
+	}
+	return s[:end] + opts.Ellipsis, nil
+}
+
+// wrapString breaks s into lines of at most width columns at UAX #14 line
+// break opportunities, splitting between grapheme clusters only when a
+// single word is wider than a line. Existing line breaks are kept.
+func wrapString(s string, width int) (string, error) {
+	if width < 1 || width > maxTextWidth {
+		return "", ErrLengthRange
+	}
+
+	var out, line strings.Builder
+	lineWidth := 0
+	flush := func() {
+		out.WriteString(strings.TrimRight(line.String(), " "))
+		line.Reset()
+		lineWidth = 0
+	}
+
+	state := -1
+	for rest := s; rest != ""; {
+		var segment string
+		var mustBreak bool
+		segment, rest, mustBreak, state = uniseg.FirstLineSegmentInString(rest, state)
+		text := strings.TrimRight(segment, "\r\n")
+		newline := segment[len(text):]
+		visible := uniseg.StringWidth(strings.TrimRight(text, " "))
+
+		if lineWidth > 0 && lineWidth+visible > width {
+			flush()
+			out.WriteByte('\n')
+		}
+		for visible > width {
+			// A word wider than a line is cut between clusters.
+			head, tail := splitWidth(text, width)
+			if head == "" {
+				head, tail = splitWidth(text, width+1)
+			}
+			line.WriteString(head)
+			flush()
+			out.WriteByte('\n')
+			text = tail
+			visible = uniseg.StringWidth(strings.TrimRight(text, " "))
+		}
+		line.WriteString(text)
+		lineWidth += uniseg.StringWidth(text)
+
+		if mustBreak && newline != "" {
+			flush()
+			out.WriteString(newline)
+		}
+	}
+	flush()
+	return out.String(), nil
+}
+
+func truncateParams(params map[string]string) (TruncateOptions, error) {
+	limit, err := intParam(params, "max")
+	if err != nil {
+		return TruncateOptions{}, err
+	}
+	return TruncateOptions{Unit: params["unit"], Max: limit, Ellipsis: params["ellipsis"]}, nil
+}
+
+func init() {
+	registerPipelineOp("truncate", pipelineOp{
+		params: []string{"unit", "max", "ellipsis"},
+		check: func(params map[string]string) error {
+			opts, err := truncateParams(params)
+			if err != nil {
+				return err
+			}
+			return truncateRequest{Unit: opts.Unit, Max: opts.Max, Ellipsis: opts.Ellipsis}.validate()
+		},
+		apply: func(svc StringService, s string, params map[string]string) (string, error) {
+			opts, err := truncateParams(params)
+			if err != nil {
+				return "", err
+			}
+			return svc.Truncate(s, opts)
+		},
+	})
+
+	registerPipelineOp("wrap", pipelineOp{
+		params: []string{"width"},
+		check: func(params map[string]string) error {
+			width, err := intParam(params, "width")
+			if err != nil {
+				return err
+			}
+			return wrapRequest{Width: width}.validate()
+		},
+		apply: func(svc StringService, s string, params map[string]string) (string, error) {
+			width, err := intParam(params, "width")
+			if err != nil {
+				return "", err
+			}
+			return svc.Wrap(s, width)
+		},
+	})
+}
+
+// splitWidth splits s after the grapheme clusters that fit in width columns.
+func splitWidth(s string, width int) (head string, tail string) {
+	end, used, state := 0, 0, -1
+	for rest := s; rest != ""; {
+		var cluster string
+		var boundaries int
+		cluster, rest, boundaries, state = uniseg.StepString(rest, state)
+		w := boundaries >> uniseg.ShiftWidth
+		if used+w > width {
+			break
+		}
+		used += w
+		end += len(cluster)
+	}
+	return s[:end], s[end:]
+}
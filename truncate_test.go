@@ -0,0 +1,46 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTruncate(t *testing.T) {
+	s := "日本👩‍👩‍👧語テキスト"
+	for _, tc := range []struct {
+		opts TruncateOptions
+		want string
+		err  error
+	}{
+		{TruncateOptions{Max: 100}, s, nil},
+		{TruncateOptions{Max: 5}, "日", nil},
+		{TruncateOptions{Unit: CountRunes, Max: 4}, "日本", nil},
+		{TruncateOptions{Unit: CountRunes, Max: 7}, "日本👩‍👩‍👧", nil},
+		{TruncateOptions{Unit: CountGraphemes, Max: 4, Ellipsis: "…"}, "日本👩‍👩‍👧…", nil},
+		{TruncateOptions{Unit: CountWidth, Max: 5, Ellipsis: "…"}, "日本…", nil},
+		{TruncateOptions{Unit: CountWidth, Max: 1, Ellipsis: "..."}, "", ErrEllipsisTooLong},
+		{TruncateOptions{Unit: "words", Max: 1}, "", ErrUnknownUnit},
+	} {
+		got, err := truncateString(s, tc.opts)
+		assert.Equal(t, tc.err, err, "%+v", tc.opts)
+		assert.Equal(t, tc.want, got, "%+v", tc.opts)
+	}
+}
+
+func TestWrap(t *testing.T) {
+	for _, tc := range []struct {
+		s     string
+		width int
+		want  string
+	}{
+		{"The quick brown fox jumps over the lazy dog.", 10, "The quick\nbrown fox\njumps over\nthe lazy\ndog."},
+		{"one two\n\nthree four", 9, "one two\n\nthree\nfour"},
+		{"日本語のテキストです", 6, "日本語\nのテキ\nストで\nす"},
+		{"supercalifragilistic", 8, "supercal\nifragili\nstic"},
+	} {
+		got, err := wrapString(tc.s, tc.width)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.want, got, "%q at %d", tc.s, tc.width)
+	}
+}
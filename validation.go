@@ -0,0 +1,475 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gorilla/mux"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Limits applied to every decoded request
+
+type validationLimits struct {
+	maxBodyBytes   int64
+	maxStringBytes int
+	maxBatchItems  int
+	maxSteps       int
+}
+
+// Field-level validation errors
+
+type fieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"error"`
+}
+
+type validationError []fieldError
+
+func (e validationError) Error() string {
+	msgs := make([]string, len(e))
+	for i, fe := range e {
+		msgs[i] = fmt.Sprintf("%s: %s", fe.Field, fe.Message)
+	}
+	return "invalid request: " + strings.Join(msgs, "; ")
+}
+
+// StatusCode implements httptransport.StatusCoder.
+func (e validationError) StatusCode() int {
+	return http.StatusBadRequest
+}
+
+// GRPCStatus lets grpc report the error as InvalidArgument with a BadRequest detail.
+func (e validationError) GRPCStatus() *status.Status {
+	st := status.New(codes.InvalidArgument, e.Error())
+	br := &errdetails.BadRequest{}
+	for _, fe := range e {
+		br.FieldViolations = append(br.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       fe.Field,
+			Description: fe.Message,
+		})
+	}
+	if detailed, err := st.WithDetails(br); err == nil {
+		return detailed
+	}
+	return st
+}
+
+type validator interface {
+	validate() error
+}
+
+func validateFields(checks ...*fieldError) error {
+	var errs validationError
+	for _, fe := range checks {
+		if fe != nil {
+			errs = append(errs, *fe)
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+func checkString(field string, s string) *fieldError {
+	if !utf8.ValidString(s) {
+		return &fieldError{field, "invalid UTF-8"}
+	}
+	if len(s) > limits.maxStringBytes {
+		return &fieldError{field, fmt.Sprintf("exceeds %d bytes", limits.maxStringBytes)}
+	}
+	return nil
+}
+
+func checkRequired(field string, s string) *fieldError {
+	if s == "" {
+		return &fieldError{field, "required"}
+	}
+	return checkString(field, s)
+}
+
+func checkLocale(field string, locale string) *fieldError {
+	if _, err := parseLocale(locale); err != nil {
+		return &fieldError{field, "invalid BCP-47 language tag"}
+	}
+	return nil
+}
+
+func checkOneOf(field string, s string, allowed ...string) *fieldError {
+	if s == "" {
+		return nil
+	}
+	for _, a := range allowed {
+		if s == a {
+			return nil
+		}
+	}
+	return &fieldError{field, "must be one of " + strings.Join(allowed, ", ")}
+}
+
+func (r uppercaseRequest) validate() error {
+	return validateFields(checkString("s", r.S), checkLocale("locale", r.Locale))
+}
+
+func (r lowercaseRequest) validate() error {
+	return validateFields(checkString("s", r.S), checkLocale("locale", r.Locale))
+}
+
+func (r titleCaseRequest) validate() error {
+	return validateFields(checkString("s", r.S), checkLocale("locale", r.Locale))
+}
+
+func (r countRequest) validate() error {
+	return validateFields(
+		checkString("s", r.S),
+		checkOneOf("unit", r.Unit, CountBytes, CountRunes, CountGraphemes),
+	)
+}
+
+func (r normalizeRequest) validate() error {
+	return validateFields(
+		checkString("s", r.S),
+		checkOneOf("form", r.Form, "NFC", "NFD", "NFKC", "NFKD"),
+	)
+}
+
+func (r analyzeRequest) validate() error {
+	return validateFields(checkString("s", r.S))
+}
+
+func (r batchRequest) validate() error {
+	checks := []*fieldError{}
+	switch {
+	case len(r.Items) == 0:
+		checks = append(checks, &fieldError{"items", "required"})
+	case len(r.Items) > limits.maxBatchItems:
+		checks = append(checks, &fieldError{"items", fmt.Sprintf("exceeds %d items", limits.maxBatchItems)})
+	}
+	for i, item := range r.Items {
+		checks = append(checks, checkString(fmt.Sprintf("items[%d].s", i), item.S))
+	}
+	return validateFields(checks...)
+}
+
+func (r transformRequest) validate() error {
+	return validateFields(checkString("s", r.S))
+}
+
+func (r pipelineRequest) validate() error {
+	checks := []*fieldError{checkString("s", r.S)}
+	switch {
+	case len(r.Steps) == 0:
+		checks = append(checks, &fieldError{"steps", "required"})
+	case len(r.Steps) > limits.maxSteps:
+		checks = append(checks, &fieldError{"steps", fmt.Sprintf("exceeds %d steps", limits.maxSteps)})
+	}
+	for i, step := range r.Steps {
+		for name, value := range step.Params {
+			checks = append(checks, checkString(fmt.Sprintf("steps[%d].params.%s", i, name), value))
+		}
+		err := checkPipelineStep(step)
+		if fields, ok := nestedFields(fmt.Sprintf("steps[%d].params", i), err).(validationError); ok {
+			for j := range fields {
+				checks = append(checks, &fields[j])
+			}
+		} else if err == ErrUnknownOp {
+			checks = append(checks, &fieldError{fmt.Sprintf("steps[%d].op", i), "must be one of " + strings.Join(pipelineOpNames(), ", ")})
+		} else if err != nil {
+			checks = append(checks, &fieldError{fmt.Sprintf("steps[%d].params", i), err.Error()})
+		}
+	}
+	return validateFields(checks...)
+}
+
+func checkPattern(field string, pattern string, flags string) *fieldError {
+	if fe := checkRequired(field, pattern); fe != nil {
+		return fe
+	}
+	if _, err := compiledPatterns.get(pattern, flags); err != nil {
+		return &fieldError{field, err.Error()}
+	}
+	return nil
+}
+
+func checkFlagsField(field string, flags string) *fieldError {
+	if err := checkFlags(flags); err != nil {
+		return &fieldError{field, err.Error()}
+	}
+	return nil
+}
+
+func (r replaceRequest) validate() error {
+	if fe := checkFlagsField("flags", r.Flags); fe != nil {
+		return validateFields(fe)
+	}
+	return validateFields(
+		checkString("s", r.S),
+		checkPattern("pattern", r.Pattern, r.Flags),
+		checkString("replacement", r.Replacement),
+	)
+}
+
+func (r extractRequest) validate() error {
+	if fe := checkFlagsField("flags", r.Flags); fe != nil {
+		return validateFields(fe)
+	}
+	checks := []*fieldError{
+		checkString("s", r.S),
+		checkPattern("pattern", r.Pattern, r.Flags),
+	}
+	if r.Limit < 0 || r.Limit > defaultMatchLimit {
+		checks = append(checks, &fieldError{"limit", fmt.Sprintf("must be between 0 and %d", defaultMatchLimit)})
+	}
+	return validateFields(checks...)
+}
+
+func checkEncoding(field string, encoding string) *fieldError {
+	if _, ok := codecs[encoding]; !ok {
+		return &fieldError{field, "must be one of " + strings.Join(encodingNames(), ", ")}
+	}
+	return nil
+}
+
+func (r encodeStringRequest) validate() error {
+	return validateFields(checkString("s", r.S), checkEncoding("encoding", r.Encoding))
+}
+
+func (r decodeStringRequest) validate() error {
+	return validateFields(checkString("s", r.S), checkEncoding("encoding", r.Encoding))
+}
+
+func (r hashRequest) validate() error {
+	checks := []*fieldError{
+		checkString("s", r.S),
+		checkString("key", r.Key),
+		checkOneOf("output", r.Output, "hex", "base64"),
+	}
+	switch err := checkHashOptions(HashOptions{r.Algorithm, "", r.Key}); err {
+	case ErrUnknownAlgorithm:
+		checks = append(checks, &fieldError{"algorithm", "must be one of " + strings.Join(hashAlgorithmNames(), ", ")})
+	case ErrHMACUnsupported:
+		checks = append(checks, &fieldError{"key", err.Error()})
+	}
+	return validateFields(checks...)
+}
+
+func checkCompared(field string, s string) *fieldError {
+	if fe := checkString(field, s); fe != nil {
+		return fe
+	}
+	if err := checkCompareSize(s); err != nil {
+		return &fieldError{field, err.Error()}
+	}
+	return nil
+}
+
+func (r compareRequest) validate() error {
+	return validateFields(checkCompared("a", r.A), checkCompared("b", r.B))
+}
+
+func checkDiffed(field string, s string, mode string) *fieldError {
+	if fe := checkString(field, s); fe != nil {
+		return fe
+	}
+	if _, err := diffTokens(s, mode); err != nil {
+		return &fieldError{field, err.Error()}
+	}
+	return nil
+}
+
+func (r diffRequest) validate() error {
+	checks := []*fieldError{
+		checkOneOf("mode", r.Mode, DiffLines, DiffChars),
+		checkOneOf("format", r.Format, DiffUnified, DiffEdits),
+	}
+	if r.Context < 0 || r.Context > maxDiffContext {
+		checks = append(checks, &fieldError{"context", fmt.Sprintf("must be between 0 and %d", maxDiffContext)})
+	}
+	if fe := validateFields(checks...); fe != nil {
+		return fe
+	}
+	return validateFields(checkDiffed("a", r.A, r.Mode), checkDiffed("b", r.B, r.Mode))
+}
+
+func (r slugifyRequest) validate() error {
+	checks := []*fieldError{
+		checkString("s", r.S),
+		checkOneOf("separator", r.Separator, "-", "_", "."),
+	}
+	if r.MaxLength < 0 || r.MaxLength > maxSlugLength {
+		checks = append(checks, &fieldError{"max_length", fmt.Sprintf("must be between 0 and %d", maxSlugLength)})
+	}
+	if len(r.Existing) > limits.maxBatchItems {
+		checks = append(checks, &fieldError{"existing", fmt.Sprintf("exceeds %d items", limits.maxBatchItems)})
+	}
+	for i, e := range r.Existing {
+		checks = append(checks, checkString(fmt.Sprintf("existing[%d]", i), e))
+	}
+	return validateFields(checks...)
+}
+
+func (r tokenizeRequest) validate() error {
+	checks := []*fieldError{
+		checkString("s", r.S),
+		checkOneOf("unit", r.Unit, TokenWords, TokenSentences, TokenNGrams),
+		checkString("stop_words", r.StopWords),
+	}
+	if r.N < 0 || r.N > maxNGram {
+		checks = append(checks, &fieldError{"n", fmt.Sprintf("must be between 0 and %d", maxNGram)})
+	}
+	return validateFields(checks...)
+}
+
+func (r renderRequest) validate() error {
+	if fe := checkRequired("template", r.Template); fe != nil {
+		return validateFields(fe)
+	}
+	if _, err := parseTemplate(stringService{}, r.Template); err != nil {
+		return validateFields(&fieldError{"template", err.Error()})
+	}
+	return nil
+}
+
+func (r redactRequest) validate() error {
+	checks := []*fieldError{
+		checkString("s", r.S),
+		checkOneOf("style", r.Style, MaskFull, MaskPartial, MaskHash),
+	}
+	for i, d := range r.Detectors {
+		checks = append(checks, checkOneOf(fmt.Sprintf("detectors[%d]", i), d, detectorOrder...))
+		if d == "" {
+			checks = append(checks, &fieldError{fmt.Sprintf("detectors[%d]", i), "required"})
+		}
+	}
+	return validateFields(checks...)
+}
+
+func (r detectLanguageRequest) validate() error {
+	return validateFields(checkString("s", r.S))
+}
+
+func checkLength(field string, n int) *fieldError {
+	if n < 1 || n > maxTextWidth {
+		return &fieldError{field, fmt.Sprintf("must be between 1 and %d", maxTextWidth)}
+	}
+	return nil
+}
+
+func (r truncateRequest) validate() error {
+	checks := []*fieldError{
+		checkString("s", r.S),
+		checkOneOf("unit", r.Unit, CountBytes, CountRunes, CountGraphemes, CountWidth),
+		checkLength("max", r.Max),
+		checkString("ellipsis", r.Ellipsis),
+	}
+	if fe := validateFields(checks...); fe != nil {
+		return fe
+	}
+	if measure(r.Ellipsis, r.Unit) > r.Max {
+		return validateFields(&fieldError{"ellipsis", ErrEllipsisTooLong.Error()})
+	}
+	return nil
+}
+
+func (r wrapRequest) validate() error {
+	return validateFields(checkString("s", r.S), checkLength("width", r.Width))
+}
+
+// nestedFields prefixes the fields of a validation error with the field
+// holding the nested request.
+func nestedFields(field string, err error) error {
+	fields, ok := err.(validationError)
+	if !ok {
+		return err
+	}
+	nested := make(validationError, len(fields))
+	for i, fe := range fields {
+		nested[i] = fieldError{field + "." + fe.Field, fe.Message}
+	}
+	return nested
+}
+
+func (r submitJobRequest) validate() error {
+	if err := validateFields(checkCallbackURL("callback_url", r.CallbackURL)); err != nil {
+		return err
+	}
+	switch {
+	case (r.Batch == nil) == (r.Pipeline == nil):
+		return validationError{{"batch", "exactly one of batch or pipeline is required"}}
+	case r.Batch != nil:
+		return nestedFields("batch", r.Batch.validate())
+	}
+	return nestedFields("pipeline", r.Pipeline.validate())
+}
+
+func (r jobRequest) validate() error {
+	return validateFields(checkRequired("id", r.ID))
+}
+
+func (r authRequest) validate() error {
+	return validateFields(
+		checkRequired("username", r.Username),
+		checkRequired("password", r.Password),
+	)
+}
+
+// HTTP helpers
+
+// limitBody caps request bodies with http.MaxBytesReader.
+func limitBody(maxBytes int64) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			r.Body = http.MaxBytesReader(w, r.Body, maxBytes)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// decodeJSONBody strictly decodes the request body into v and validates it.
+func decodeJSONBody(r *http.Request, v validator) error {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			return validationError{{"body", fmt.Sprintf("exceeds %d bytes", tooLarge.Limit)}}
+		}
+		return err
+	}
+	return decodeJSON(body, v)
+}
+
+// decodeJSON strictly decodes body into v and validates it. JSON decoding
+// replaces invalid UTF-8 with U+FFFD, so the raw body is checked first.
+func decodeJSON(body []byte, v validator) error {
+	if !utf8.Valid(body) {
+		return validationError{{"body", "invalid UTF-8"}}
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil {
+		return jsonFieldError(err)
+	}
+
+	return v.validate()
+}
+
+func jsonFieldError(err error) error {
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) && typeErr.Field != "" {
+		return validationError{{typeErr.Field, "expected " + typeErr.Type.String()}}
+	}
+	if field := strings.TrimPrefix(err.Error(), "json: unknown field "); field != err.Error() {
+		return validationError{{strings.Trim(field, `"`), "unknown field"}}
+	}
+	return validationError{{"body", err.Error()}}
+}
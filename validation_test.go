@@ -0,0 +1,121 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/fnaumov/gokit-stringsvc/pb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestValidation(t *testing.T) {
+	tooLong := strings.Repeat("a", limits.maxStringBytes+1)
+
+	for _, tc := range []struct {
+		request validator
+		want    validationError
+	}{
+		{uppercaseRequest{S: "hello", Locale: "tr"}, nil},
+		{uppercaseRequest{S: "\xff"}, validationError{{"s", "invalid UTF-8"}}},
+		{lowercaseRequest{S: tooLong}, validationError{{"s", fmt.Sprintf("exceeds %d bytes", limits.maxStringBytes)}}},
+		{titleCaseRequest{Locale: "not a locale"}, validationError{{"locale", "invalid BCP-47 language tag"}}},
+		{countRequest{Unit: "words"}, validationError{{"unit", "must be one of bytes, runes, graphemes"}}},
+		{normalizeRequest{Form: "NFX"}, validationError{{"form", "must be one of NFC, NFD, NFKC, NFKD"}}},
+		{batchRequest{}, validationError{{"items", "required"}}},
+		{batchRequest{Items: make([]batchItem, limits.maxBatchItems+1)}, validationError{{"items", fmt.Sprintf("exceeds %d items", limits.maxBatchItems)}}},
+		{batchRequest{Items: []batchItem{{OpUppercase, "ok"}, {OpUppercase, "\xff"}}}, validationError{{"items[1].s", "invalid UTF-8"}}},
+		{pipelineRequest{S: "abc", Steps: []PipelineStep{{Op: "truncate", Params: map[string]string{"max": "0"}}}}, validationError{{"steps[0].params.max", "must be between 1 and 65536"}}},
+		{pipelineRequest{S: "abc", Steps: []PipelineStep{{Op: "replace", Params: map[string]string{"replacement": "x"}}}}, validationError{{"steps[0].params.pattern", "required"}}},
+		{authRequest{}, validationError{{"username", "required"}, {"password", "required"}}},
+	} {
+		err := tc.request.validate()
+		if tc.want == nil {
+			assert.NoError(t, err, "%T", tc.request)
+			continue
+		}
+		assert.Equal(t, tc.want, err, "%T", tc.request)
+	}
+}
+
+func TestDecodeJSON(t *testing.T) {
+	for _, tc := range []struct {
+		body string
+		want validationError
+	}{
+		{`{"s": "hello"}`, nil},
+		{"{\"s\": \"\xff\"}", validationError{{"body", "invalid UTF-8"}}},
+		{`{"s": 42}`, validationError{{"s", "expected string"}}},
+		{`{"s": "hello", "extra": true}`, validationError{{"extra", "unknown field"}}},
+		{`{"s": `, validationError{{"body", "unexpected EOF"}}},
+	} {
+		var request uppercaseRequest
+		err := decodeJSON([]byte(tc.body), &request)
+		if tc.want == nil {
+			assert.NoError(t, err, tc.body)
+			continue
+		}
+		assert.Equal(t, tc.want, err, tc.body)
+	}
+}
+
+func TestHTTPValidationResponse(t *testing.T) {
+	srv := httptest.NewServer(makeHTTPHandler(makeSvc(), makeJobs(makeSvc())))
+	defer srv.Close()
+
+	post := func(body string) (*http.Response, map[string]interface{}) {
+		req, _ := http.NewRequest("POST", srv.URL+"/uppercase", strings.NewReader(body))
+		req.Header.Set("Authorization", "Bearer "+testToken(t))
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		var response map[string]interface{}
+		_ = json.NewDecoder(resp.Body).Decode(&response)
+		return resp, response
+	}
+
+	resp, response := post(`{"s": "hello", "locale": "??"}`)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Equal(t, "application/json; charset=utf-8", resp.Header.Get("Content-Type"))
+	assert.Equal(t, "invalid request: locale: invalid BCP-47 language tag", response["error"])
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"field": "locale", "error": "invalid BCP-47 language tag"},
+	}, response["fields"])
+
+	resp, response = post(`{"s": "` + strings.Repeat("a", int(limits.maxBodyBytes)) + `"}`)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"field": "body", "error": fmt.Sprintf("exceeds %d bytes", limits.maxBodyBytes)},
+	}, response["fields"])
+}
+
+func TestGRPCValidationResponse(t *testing.T) {
+	conn, stop := dialTestGRPCServer(t, makeSvc())
+	defer stop()
+	client := pb.NewStringServiceClient(conn)
+
+	md := metadata.Pairs("authorization", fmt.Sprintf("Bearer %s", testToken(t)))
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	_, err := client.Count(ctx, &pb.CountRequest{S: "hello", Unit: "words"})
+
+	st := status.Convert(err)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	assert.Equal(t, "invalid request: unit: must be one of bytes, runes, graphemes", st.Message())
+	if assert.Len(t, st.Details(), 1) {
+		violations := st.Details()[0].(*errdetails.BadRequest).FieldViolations
+		if assert.Len(t, violations, 1) {
+			assert.Equal(t, "unit", violations[0].Field)
+			assert.Equal(t, "must be one of bytes, runes, graphemes", violations[0].Description)
+		}
+	}
+}
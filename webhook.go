@@ -0,0 +1,184 @@
+package main
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// Webhook request headers. The signature is the hex HMAC-SHA256 of the
+// timestamp, a dot and the body, so receivers can reject old deliveries.
+const (
+	webhookSignatureHeader = "X-Signature-256"
+	webhookTimestampHeader = "X-Signature-Timestamp"
+	webhookJobHeader       = "X-Job-ID"
+	webhookAttemptHeader   = "X-Delivery-Attempt"
+	maxCallbackURLLength   = 2048
+)
+
+var ErrCallbackAddress = errors.New("callback address is not public")
+
+// internalNetworks are the private, shared and unique local ranges, which
+// callbacks may not reach on top of loopback and link-local addresses.
+var internalNetworks = parseCIDRs("0.0.0.0/8", "10.0.0.0/8", "100.64.0.0/10", "172.16.0.0/12", "192.168.0.0/16", "fc00::/7")
+
+func parseCIDRs(cidrs ...string) []*net.IPNet {
+	nets := make([]*net.IPNet, len(cidrs))
+	for i, cidr := range cidrs {
+		_, nets[i], _ = net.ParseCIDR(cidr)
+	}
+	return nets
+}
+
+// publicIP reports whether ip is outside the networks of the service, so
+// that callbacks cannot be used to probe them.
+func publicIP(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified() {
+		return false
+	}
+	for _, n := range internalNetworks {
+		if n.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// DeliveryAttempt records one POST of a job result to its callback URL.
+// StatusCode is 0 when no response was received.
+type DeliveryAttempt struct {
+	Attempt    int
+	Time       time.Time
+	StatusCode int
+	Error      string
+	Duration   time.Duration
+}
+
+// webhookSender posts job results, retrying failed deliveries after a delay
+// doubling from backoff, up to attempts times. Connections are only made to
+// addresses accepted by allowed, which is checked after DNS resolution so
+// that a host cannot be rebound to an internal address after validation.
+type webhookSender struct {
+	client   *http.Client
+	key      []byte
+	attempts int
+	backoff  time.Duration
+	allowed  func(net.IP) bool
+}
+
+func newWebhookSender(key []byte, attempts int, backoff time.Duration, timeout time.Duration) *webhookSender {
+	s := &webhookSender{key: key, attempts: attempts, backoff: backoff, allowed: publicIP}
+	dialer := &net.Dialer{Timeout: timeout, Control: s.control}
+	s.client = &http.Client{
+		Timeout:   timeout,
+		Transport: &http.Transport{DialContext: dialer.DialContext},
+	}
+	return s
+}
+
+func (s *webhookSender) control(network string, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	if ip := net.ParseIP(host); ip == nil || !s.allowed(ip) {
+		return ErrCallbackAddress
+	}
+	return nil
+}
+
+func signWebhook(key []byte, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// deliver posts the job to its callback URL until a 2xx response, passing
+// every attempt to record.
+func (s *webhookSender) deliver(callbackURL string, job Job, record func(DeliveryAttempt)) {
+	body, err := json.Marshal(makeJobResponse(job, nil))
+	if err != nil {
+		record(DeliveryAttempt{Attempt: 1, Time: time.Now(), Error: err.Error()})
+		return
+	}
+
+	delay := s.backoff
+	for attempt := 1; attempt <= s.attempts; attempt++ {
+		a := s.post(callbackURL, job.ID, body, attempt)
+		record(a)
+		if a.Error == "" {
+			return
+		}
+		if attempt < s.attempts {
+			time.Sleep(delay)
+			delay *= 2
+		}
+	}
+}
+
+func (s *webhookSender) post(callbackURL string, id string, body []byte, attempt int) DeliveryAttempt {
+	a := DeliveryAttempt{Attempt: attempt, Time: time.Now()}
+	defer func() { a.Duration = time.Since(a.Time) }()
+
+	req, err := http.NewRequest("POST", callbackURL, bytes.NewReader(body))
+	if err != nil {
+		a.Error = err.Error()
+		return a
+	}
+	timestamp := strconv.FormatInt(a.Time.Unix(), 10)
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	req.Header.Set(webhookTimestampHeader, timestamp)
+	req.Header.Set(webhookSignatureHeader, signWebhook(s.key, timestamp, body))
+	req.Header.Set(webhookJobHeader, id)
+	req.Header.Set(webhookAttemptHeader, strconv.Itoa(attempt))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		a.Error = err.Error()
+		return a
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(ioutil.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	a.StatusCode = resp.StatusCode
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		a.Error = fmt.Sprintf("unexpected status %s", resp.Status)
+	}
+	return a
+}
+
+// checkCallbackURL checks the scheme and host of a callback URL without
+// resolving it, which would hold up the request on a slow name server;
+// names are checked when delivery dials them.
+func checkCallbackURL(field string, s string) *fieldError {
+	if s == "" {
+		return nil
+	}
+	if len(s) > maxCallbackURLLength {
+		return &fieldError{field, fmt.Sprintf("exceeds %d bytes", maxCallbackURLLength)}
+	}
+	u, err := url.Parse(s)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" {
+		return &fieldError{field, "must be an absolute http or https URL"}
+	}
+	host := strings.ToLower(strings.TrimSuffix(u.Hostname(), "."))
+	ip := net.ParseIP(host)
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") || ip != nil && !publicIP(ip) {
+		return &fieldError{field, "must not point to a private, loopback or link-local address"}
+	}
+	return nil
+}
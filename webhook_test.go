@@ -0,0 +1,132 @@
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJobWebhook(t *testing.T) {
+	key := []byte("test_webhook_key")
+	var calls int32
+	received := make(chan jobResponse, 1)
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.Equal(t, signWebhook(key, r.Header.Get(webhookTimestampHeader), body), r.Header.Get(webhookSignatureHeader))
+
+		// The first delivery fails and is retried.
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		var job jobResponse
+		_ = json.Unmarshal(body, &job)
+		assert.Equal(t, job.ID, r.Header.Get(webhookJobHeader))
+		assert.Equal(t, "2", r.Header.Get(webhookAttemptHeader))
+		received <- job
+	}))
+	defer receiver.Close()
+
+	webhooks := newWebhookSender(key, 3, time.Millisecond, time.Second)
+	webhooks.allowed = func(net.IP) bool { return true }
+	jobs := newJobManager(makeSvc(), webhooks, 1, 10, time.Minute, jobRetained, jobRetainedBytes)
+	submitted, err := jobs.Submit("user1", JobSpec{
+		Batch:       []batchItem{{OpUppercase, "hello"}},
+		CallbackURL: receiver.URL,
+	})
+	assert.NoError(t, err)
+
+	select {
+	case job := <-received:
+		assert.Equal(t, submitted.ID, job.ID)
+		assert.Equal(t, JobSucceeded, job.Status)
+		assert.Equal(t, "HELLO", job.Results[0].V)
+	case <-time.After(5 * time.Second):
+		t.Fatal("no delivery")
+	}
+
+	var deliveries []DeliveryAttempt
+	for deadline := time.Now().Add(time.Second); len(deliveries) < 2 && time.Now().Before(deadline); {
+		time.Sleep(5 * time.Millisecond)
+		deliveries, _ = jobs.Deliveries("user1", submitted.ID)
+	}
+	if assert.Len(t, deliveries, 2) {
+		assert.Equal(t, http.StatusServiceUnavailable, deliveries[0].StatusCode)
+		assert.NotEmpty(t, deliveries[0].Error)
+		assert.Equal(t, http.StatusOK, deliveries[1].StatusCode)
+		assert.Empty(t, deliveries[1].Error)
+	}
+
+	_, err = jobs.Deliveries("user2", submitted.ID)
+	assert.Equal(t, ErrJobNotFound, err)
+}
+
+func TestWebhookGivesUp(t *testing.T) {
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer receiver.Close()
+
+	var attempts []DeliveryAttempt
+	webhooks := newWebhookSender(nil, 3, time.Millisecond, time.Second)
+	webhooks.allowed = func(net.IP) bool { return true }
+	webhooks.deliver(receiver.URL, Job{ID: "job"}, func(a DeliveryAttempt) {
+		attempts = append(attempts, a)
+	})
+
+	assert.Len(t, attempts, 3)
+	assert.Equal(t, 3, attempts[2].Attempt)
+	assert.Equal(t, http.StatusInternalServerError, attempts[2].StatusCode)
+}
+
+func TestWebhookRefusesInternalAddresses(t *testing.T) {
+	var calls int32
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+	}))
+	defer receiver.Close()
+
+	var attempts []DeliveryAttempt
+	newWebhookSender(nil, 1, time.Millisecond, time.Second).deliver(receiver.URL, Job{ID: "job"}, func(a DeliveryAttempt) {
+		attempts = append(attempts, a)
+	})
+
+	assert.Equal(t, int32(0), atomic.LoadInt32(&calls))
+	if assert.Len(t, attempts, 1) {
+		assert.Equal(t, 0, attempts[0].StatusCode)
+		assert.Contains(t, attempts[0].Error, ErrCallbackAddress.Error())
+	}
+}
+
+func TestCheckCallbackURL(t *testing.T) {
+	assert.Nil(t, checkCallbackURL("callback_url", ""))
+	assert.Nil(t, checkCallbackURL("callback_url", "https://93.184.215.14/hook"))
+	assert.Nil(t, checkCallbackURL("callback_url", "https://[2606:2800:21f:cb07:6820:80da:af6b:8b2c]:8443/hook"))
+	assert.NotNil(t, checkCallbackURL("callback_url", "ftp://example.com/hook"))
+	assert.NotNil(t, checkCallbackURL("callback_url", "/hook"))
+	// Names are only resolved when delivering.
+	assert.Nil(t, checkCallbackURL("callback_url", "http://host.invalid/hook"))
+
+	for _, internal := range []string{
+		"http://localhost:8080/hook",
+		"http://api.localhost./hook",
+		"http://127.0.0.1/hook",
+		"http://169.254.169.254/latest/meta-data",
+		"http://10.1.2.3/hook",
+		"http://172.20.0.1/hook",
+		"http://192.168.1.1/hook",
+		"http://[::1]/hook",
+		"http://[fd00::1]/hook",
+		"http://[::ffff:127.0.0.1]/hook",
+		"http://0.0.0.0/hook",
+	} {
+		assert.NotNil(t, checkCallbackURL("callback_url", internal), internal)
+	}
+}